@@ -2,11 +2,30 @@ package config
 
 import (
 	"os"
+	"strconv"
+	"time"
 )
 
 type Config struct {
-	Port        string
-	DatabaseURL string
+	Port           string
+	DatabaseURL    string
+	UploadDir      string
+	MaxUploadBytes int64
+
+	// On-chain anchoring of submission roots; disabled when RPC URL or key is empty
+	AnchorRPCURL     string
+	AnchorPrivateKey string
+	AnchorContract   string
+	AnchorInterval   time.Duration
+
+	// Git repository snapshots at the submission deadline; disabled when git is not installed
+	GitBinary           string
+	GitAllowProtocols   string
+	GitSnapshotInterval time.Duration
+	GitSnapshotMaxBytes int64
+
+	// Proof-of-personhood checks for public voters; disabled when the URL is empty
+	PersonhoodVerifierURL string
 }
 
 func Load() *Config {
@@ -22,9 +41,59 @@ func Load() *Config {
 		databaseURL = "root:password@tcp(127.0.0.1:3306)/hackathon_db?parseTime=true&charset=utf8mb4&loc=Local"
 	}
 
+	uploadDir := os.Getenv("UPLOAD_DIR")
+	if uploadDir == "" {
+		uploadDir = "./data/uploads"
+	}
+
+	// 单个文件的默认上传上限，活动可单独配置更小或更大的值
+	maxUploadBytes := int64(50 << 20)
+	if v, err := strconv.ParseInt(os.Getenv("UPLOAD_MAX_BYTES"), 10, 64); err == nil && v > 0 {
+		maxUploadBytes = v
+	}
+
+	anchorInterval := time.Minute
+	if v, err := time.ParseDuration(os.Getenv("ANCHOR_INTERVAL")); err == nil && v > 0 {
+		anchorInterval = v
+	}
+
+	gitBinary := os.Getenv("GIT_BINARY")
+	if gitBinary == "" {
+		gitBinary = "git"
+	}
+
+	// 仅允许 https 克隆，测试时可设置为 "https:file" 以使用本地裸仓库
+	gitAllowProtocols := os.Getenv("GIT_ALLOW_PROTOCOL")
+	if gitAllowProtocols == "" {
+		gitAllowProtocols = "https"
+	}
+
+	gitSnapshotInterval := 5 * time.Minute
+	if v, err := time.ParseDuration(os.Getenv("GIT_SNAPSHOT_INTERVAL")); err == nil && v > 0 {
+		gitSnapshotInterval = v
+	}
+
+	gitSnapshotMaxBytes := int64(200 << 20)
+	if v, err := strconv.ParseInt(os.Getenv("GIT_SNAPSHOT_MAX_BYTES"), 10, 64); err == nil && v > 0 {
+		gitSnapshotMaxBytes = v
+	}
+
 	return &Config{
-		Port:        port,
-		DatabaseURL: databaseURL,
+		Port:             port,
+		DatabaseURL:      databaseURL,
+		UploadDir:        uploadDir,
+		MaxUploadBytes:   maxUploadBytes,
+		AnchorRPCURL:     os.Getenv("ANCHOR_RPC_URL"),
+		AnchorPrivateKey: os.Getenv("ANCHOR_PRIVATE_KEY"),
+		AnchorContract:   os.Getenv("ANCHOR_CONTRACT_ADDRESS"),
+		AnchorInterval:   anchorInterval,
+
+		GitBinary:           gitBinary,
+		GitAllowProtocols:   gitAllowProtocols,
+		GitSnapshotInterval: gitSnapshotInterval,
+		GitSnapshotMaxBytes: gitSnapshotMaxBytes,
+
+		PersonhoodVerifierURL: os.Getenv("PERSONHOOD_VERIFIER_URL"),
 	}
 }
 
@@ -0,0 +1,100 @@
+package controllers
+
+import (
+	"errors"
+	"hackathon-platform/backend/repositories"
+	"hackathon-platform/backend/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type AnchorController struct {
+	service services.AnchorService
+}
+
+// NewAnchorService builds the anchoring service shared by the scheduled job
+// and the HTTP endpoints. anchorer may be nil when anchoring is not configured.
+func NewAnchorService(db *gorm.DB, anchorer services.Anchorer) services.AnchorService {
+	return services.NewAnchorService(
+		repositories.NewSubmissionAnchorRepository(db),
+		repositories.NewEventRepository(db),
+		repositories.NewSubmissionRepository(db),
+		repositories.NewSubmissionRevisionRepository(db),
+		repositories.NewSubmissionExtensionRepository(db),
+		anchorer,
+	)
+}
+
+func NewAnchorController(service services.AnchorService) *AnchorController {
+	return &AnchorController{service: service}
+}
+
+// AnchorEvent handles POST /submissions/event/:eventId/anchor
+func (c *AnchorController) AnchorEvent(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid event ID"})
+		return
+	}
+
+	var req struct {
+		OrganizerAddress string `json:"organizer_address" binding:"required"`
+	}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	anchor, err := c.service.AnchorEvent(uint(eventID), req.OrganizerAddress)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, anchor)
+}
+
+// GetAnchor handles GET /submissions/event/:eventId/anchor
+func (c *AnchorController) GetAnchor(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid event ID"})
+		return
+	}
+
+	anchor, err := c.service.GetAnchor(uint(eventID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "event has not been anchored"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, anchor)
+}
+
+// GetSubmissionProof handles GET /submissions/:id/anchor-proof
+func (c *AnchorController) GetSubmissionProof(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid submission ID"})
+		return
+	}
+
+	proof, err := c.service.GetSubmissionProof(uint(id))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "submission has not been anchored"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, proof)
+}
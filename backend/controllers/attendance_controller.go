@@ -0,0 +1,231 @@
+package controllers
+
+import (
+	"errors"
+	"hackathon-platform/backend/repositories"
+	"hackathon-platform/backend/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// AttendanceController wires HTTP handlers to the attendance service.
+type AttendanceController struct {
+	service services.AttendanceService
+}
+
+// NewAttendanceController builds an AttendanceController with all dependencies.
+func NewAttendanceController(db *gorm.DB) *AttendanceController {
+	return &AttendanceController{service: newAttendanceService(db)}
+}
+
+func newAttendanceService(db *gorm.DB) services.AttendanceService {
+	return services.NewAttendanceService(
+		repositories.NewEventRepository(db),
+		repositories.NewAttendanceSessionRepository(db),
+		repositories.NewSessionAttendanceRepository(db),
+		repositories.NewCheckInRepository(db),
+		repositories.NewTeamRepository(db),
+		repositories.NewRegistrationRepository(db),
+	)
+}
+
+// CreateSession handles POST /attendance/event/:eventId/sessions
+func (c *AttendanceController) CreateSession(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid event ID"})
+		return
+	}
+
+	var req services.CreateSessionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	session, err := c.service.CreateSession(uint(eventID), &req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, session)
+}
+
+// ListSessions handles GET /attendance/event/:eventId/sessions
+func (c *AttendanceController) ListSessions(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid event ID"})
+		return
+	}
+
+	sessions, err := c.service.ListSessions(uint(eventID))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, sessions)
+}
+
+// UpdateSession handles PUT /attendance/sessions/:sessionId
+func (c *AttendanceController) UpdateSession(ctx *gin.Context) {
+	sessionID, err := strconv.ParseUint(ctx.Param("sessionId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid session ID"})
+		return
+	}
+
+	var req services.UpdateSessionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	session, err := c.service.UpdateSession(uint(sessionID), &req)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "Session not found"})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, session)
+}
+
+// DeleteSession handles DELETE /attendance/sessions/:sessionId
+func (c *AttendanceController) DeleteSession(ctx *gin.Context) {
+	sessionID, err := strconv.ParseUint(ctx.Param("sessionId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid session ID"})
+		return
+	}
+
+	var req struct {
+		OrganizerAddress string `json:"organizer_address" binding:"required"`
+	}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if err := c.service.DeleteSession(uint(sessionID), req.OrganizerAddress); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "Session not found"})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Session deleted successfully"})
+}
+
+// CheckIn handles POST /attendance/sessions/:sessionId/check-in
+func (c *AttendanceController) CheckIn(ctx *gin.Context) {
+	sessionID, err := strconv.ParseUint(ctx.Param("sessionId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid session ID"})
+		return
+	}
+
+	var req services.SessionAttendanceRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	attendance, err := c.service.CheckIn(uint(sessionID), &req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, attendance)
+}
+
+// CheckOut handles POST /attendance/sessions/:sessionId/check-out
+func (c *AttendanceController) CheckOut(ctx *gin.Context) {
+	sessionID, err := strconv.ParseUint(ctx.Param("sessionId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid session ID"})
+		return
+	}
+
+	var req services.SessionAttendanceRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	attendance, err := c.service.CheckOut(uint(sessionID), &req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, attendance)
+}
+
+// ListAttendees handles GET /attendance/sessions/:sessionId/attendees
+func (c *AttendanceController) ListAttendees(ctx *gin.Context) {
+	sessionID, err := strconv.ParseUint(ctx.Param("sessionId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid session ID"})
+		return
+	}
+
+	attendees, err := c.service.ListAttendees(uint(sessionID))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, attendees)
+}
+
+// GetAttendanceReport handles GET /attendance/event/:eventId/report
+func (c *AttendanceController) GetAttendanceReport(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid event ID"})
+		return
+	}
+
+	report, err := c.service.GetAttendanceReport(uint(eventID))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, report)
+}
+
+// GetTeamEligibility handles GET /attendance/event/:eventId/teams/:teamId/eligibility
+func (c *AttendanceController) GetTeamEligibility(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid event ID"})
+		return
+	}
+
+	teamID, err := strconv.ParseUint(ctx.Param("teamId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid team ID"})
+		return
+	}
+
+	eligibility, err := c.service.GetTeamEligibility(uint(eventID), uint(teamID))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, eligibility)
+}
@@ -0,0 +1,113 @@
+package controllers
+
+import (
+	"errors"
+	"hackathon-platform/backend/models"
+	"hackathon-platform/backend/repositories"
+	"hackathon-platform/backend/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// BallotController wires HTTP handlers to the ballot service.
+type BallotController struct {
+	service services.BallotService
+}
+
+// NewBallotController builds a BallotController with all dependencies.
+func NewBallotController(db *gorm.DB, live services.LiveNotifier, personhood services.PersonhoodVerifier) *BallotController {
+	ballotRepo := repositories.NewBallotRepository(db)
+	eventRepo := repositories.NewEventRepository(db)
+	submissionRepo := repositories.NewSubmissionRepository(db)
+	conflictRepo := repositories.NewJudgeConflictRepository(db)
+	nonceRepo := repositories.NewVoteNonceRepository(db)
+	publicVoters := newPublicVoterService(db, personhood)
+	service := services.NewBallotService(ballotRepo, eventRepo, submissionRepo, conflictRepo, nonceRepo, publicVoters, live)
+	return &BallotController{service: service}
+}
+
+// CastBallot handles POST /ballots
+func (c *BallotController) CastBallot(ctx *gin.Context) {
+	var req services.CastBallotRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ballot, err := c.service.CastBallot(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, ballot)
+}
+
+// PrepareBallot handles POST /ballots/typed-data
+func (c *BallotController) PrepareBallot(ctx *gin.Context) {
+	var req services.CastBallotRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	typedData, err := c.service.PrepareBallot(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, typedData)
+}
+
+// GetBallot handles GET /ballots/event/:eventId
+func (c *BallotController) GetBallot(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid event ID"})
+		return
+	}
+
+	voterAddress := ctx.Query("voter_address")
+	if voterAddress == "" {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "voter_address is required"})
+		return
+	}
+
+	ballot, err := c.service.GetBallot(uint(eventID), voterAddress)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "ballot not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, ballot)
+}
+
+// GetResults handles GET /ballots/event/:eventId/results
+func (c *BallotController) GetResults(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid event ID"})
+		return
+	}
+
+	method := models.BallotMethod(ctx.Query("method"))
+	results, err := c.service.GetResults(uint(eventID), method, viewerProof(ctx))
+	if err != nil {
+		if errors.Is(err, services.ErrTalliesHidden) {
+			ctx.JSON(http.StatusForbidden, ErrorResponse{Error: err.Error()})
+			return
+		}
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "event not found"})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, results)
+}
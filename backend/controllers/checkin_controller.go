@@ -15,11 +15,12 @@ type CheckInController struct {
 	service services.CheckInService
 }
 
-func NewCheckInController(db *gorm.DB) *CheckInController {
+func NewCheckInController(db *gorm.DB, live services.LiveNotifier) *CheckInController {
 	checkInRepo := repositories.NewCheckInRepository(db)
 	eventRepo := repositories.NewEventRepository(db)
 	teamRepo := repositories.NewTeamRepository(db)
-	service := services.NewCheckInService(checkInRepo, eventRepo, teamRepo)
+	kioskRepo := repositories.NewCheckInKioskRepository(db)
+	service := services.NewCheckInService(checkInRepo, eventRepo, teamRepo, kioskRepo, live)
 	return &CheckInController{service: service}
 }
 
@@ -190,3 +191,98 @@ func (c *CheckInController) DeleteCheckIn(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, gin.H{"message": "Check-in deleted successfully"})
 }
 
+// AuthorizeKiosk authorizes a staff device to collect offline check-ins
+func (c *CheckInController) AuthorizeKiosk(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid event ID"})
+		return
+	}
+
+	var req services.AuthorizeKioskRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	kiosk, err := c.service.AuthorizeKiosk(uint(eventID), &req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, kiosk)
+}
+
+// ListKiosks lists staff kiosks for an event
+func (c *CheckInController) ListKiosks(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid event ID"})
+		return
+	}
+
+	kiosks, err := c.service.ListKiosks(uint(eventID))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, kiosks)
+}
+
+// RevokeKiosk revokes a staff kiosk authorization
+func (c *CheckInController) RevokeKiosk(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid event ID"})
+		return
+	}
+
+	kioskID, err := strconv.ParseUint(ctx.Param("kioskId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid kiosk ID"})
+		return
+	}
+
+	var req struct {
+		OrganizerAddress string `json:"organizer_address" binding:"required"`
+	}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	kiosk, err := c.service.RevokeKiosk(uint(eventID), uint(kioskID), req.OrganizerAddress)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "Kiosk not found"})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, kiosk)
+}
+
+// SyncKioskBatch uploads a batch of offline check-ins signed by a staff kiosk
+func (c *CheckInController) SyncKioskBatch(ctx *gin.Context) {
+	var req services.KioskSyncRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	if req.IPAddress == "" {
+		req.IPAddress = ctx.ClientIP()
+	}
+
+	resp, err := c.service.SyncKioskBatch(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,120 @@
+package controllers
+
+import (
+	"errors"
+	"hackathon-platform/backend/repositories"
+	"hackathon-platform/backend/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// ConflictController wires HTTP handlers to the conflict-of-interest service.
+type ConflictController struct {
+	service services.ConflictService
+}
+
+// NewConflictController builds a ConflictController with all dependencies.
+func NewConflictController(db *gorm.DB) *ConflictController {
+	conflictRepo := repositories.NewJudgeConflictRepository(db)
+	eventJudgeRepo := repositories.NewEventJudgeRepository(db)
+	eventRepo := repositories.NewEventRepository(db)
+	teamRepo := repositories.NewTeamRepository(db)
+	submissionRepo := repositories.NewSubmissionRepository(db)
+	voteRepo := repositories.NewVoteRepository(db)
+	rubricRepo := repositories.NewRubricRepository(db)
+	service := services.NewConflictService(conflictRepo, eventJudgeRepo, eventRepo, teamRepo, submissionRepo, voteRepo, rubricRepo)
+	return &ConflictController{service: service}
+}
+
+// DeclareConflict handles POST /events/:eventId/conflicts
+func (c *ConflictController) DeclareConflict(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid event ID"})
+		return
+	}
+
+	var req services.DeclareConflictRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	conflict, err := c.service.DeclareConflict(uint(eventID), &req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusCreated, conflict)
+}
+
+// ListConflicts handles GET /events/:eventId/conflicts
+func (c *ConflictController) ListConflicts(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid event ID"})
+		return
+	}
+
+	conflicts, err := c.service.ListConflicts(uint(eventID), ctx.Query("judge_address"))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, conflicts)
+}
+
+// RemoveConflict handles DELETE /events/:eventId/conflicts/:conflictId
+func (c *ConflictController) RemoveConflict(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid event ID"})
+		return
+	}
+	conflictID, err := strconv.ParseUint(ctx.Param("conflictId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid conflict ID"})
+		return
+	}
+
+	organizerAddress := ctx.Query("organizer_address")
+	if organizerAddress == "" {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "organizer_address is required"})
+		return
+	}
+
+	if err := c.service.RemoveConflict(uint(eventID), uint(conflictID), organizerAddress); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "conflict not found"})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"message": "conflict removed"})
+}
+
+// GetViolations handles GET /events/:eventId/conflicts/violations
+func (c *ConflictController) GetViolations(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid event ID"})
+		return
+	}
+
+	organizerAddress := ctx.Query("organizer_address")
+	if organizerAddress == "" {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "organizer_address is required"})
+		return
+	}
+
+	violations, err := c.service.GetViolations(uint(eventID), organizerAddress)
+	if err != nil {
+		ctx.JSON(http.StatusForbidden, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, violations)
+}
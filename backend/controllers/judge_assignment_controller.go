@@ -0,0 +1,112 @@
+package controllers
+
+import (
+	"hackathon-platform/backend/repositories"
+	"hackathon-platform/backend/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// JudgeAssignmentController wires HTTP handlers to the judge assignment service.
+type JudgeAssignmentController struct {
+	service services.JudgeAssignmentService
+}
+
+// NewJudgeAssignmentController builds a JudgeAssignmentController with all dependencies.
+func NewJudgeAssignmentController(db *gorm.DB) *JudgeAssignmentController {
+	assignmentRepo := repositories.NewJudgeAssignmentRepository(db)
+	eventJudgeRepo := repositories.NewEventJudgeRepository(db)
+	eventRepo := repositories.NewEventRepository(db)
+	submissionRepo := repositories.NewSubmissionRepository(db)
+	teamRepo := repositories.NewTeamRepository(db)
+	voteRepo := repositories.NewVoteRepository(db)
+	rubricRepo := repositories.NewRubricRepository(db)
+	conflictRepo := repositories.NewJudgeConflictRepository(db)
+	service := services.NewJudgeAssignmentService(assignmentRepo, eventJudgeRepo, eventRepo, submissionRepo, teamRepo, voteRepo, rubricRepo, conflictRepo)
+	return &JudgeAssignmentController{service: service}
+}
+
+// AssignJudges handles POST /events/:eventId/judge-assignments
+func (c *JudgeAssignmentController) AssignJudges(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid event ID"})
+		return
+	}
+
+	var req services.AssignJudgesRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	result, err := c.service.AssignJudges(uint(eventID), &req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+// ReassignJudge handles POST /events/:eventId/judge-assignments/reassign
+func (c *JudgeAssignmentController) ReassignJudge(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid event ID"})
+		return
+	}
+
+	var req services.ReassignJudgeRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	result, err := c.service.ReassignJudge(uint(eventID), &req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+// ListAssignments handles GET /events/:eventId/judge-assignments
+func (c *JudgeAssignmentController) ListAssignments(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid event ID"})
+		return
+	}
+
+	assignments, err := c.service.ListAssignments(uint(eventID), ctx.Query("judge_address"))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, assignments)
+}
+
+// GetQueue handles GET /events/:eventId/judge-assignments/queue
+func (c *JudgeAssignmentController) GetQueue(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid event ID"})
+		return
+	}
+
+	judgeAddress := ctx.Query("judge_address")
+	if judgeAddress == "" {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "judge_address is required"})
+		return
+	}
+
+	queue, err := c.service.GetQueue(uint(eventID), judgeAddress)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, queue)
+}
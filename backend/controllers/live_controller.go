@@ -0,0 +1,129 @@
+package controllers
+
+import (
+	"errors"
+	"hackathon-platform/backend/repositories"
+	"hackathon-platform/backend/services"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// liveHeartbeat keeps idle connections open through proxies.
+const liveHeartbeat = 15 * time.Second
+
+type LiveController struct {
+	service services.LiveService
+}
+
+// NewLiveService builds the live service shared between the stream endpoint
+// and the services that publish changes.
+func NewLiveService(db *gorm.DB) services.LiveService {
+	return services.NewLiveService(
+		services.NewLiveHub(),
+		repositories.NewEventRepository(db),
+		repositories.NewCheckInRepository(db),
+		repositories.NewRegistrationRepository(db),
+		repositories.NewSubmissionRepository(db),
+		// Only builds vote summaries, so it needs no notifier or verifier
+		newVoteService(db, nil, nil),
+	)
+}
+
+func NewLiveController(service services.LiveService) *LiveController {
+	return &LiveController{service: service}
+}
+
+// Stream handles GET /events/:eventId/live as a server-sent event stream.
+// Each event is named after its topic and carries the latest snapshot.
+func (c *LiveController) Stream(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid event ID"})
+		return
+	}
+
+	topics, err := parseLiveTopics(ctx.Query("topics"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	sub, err := c.service.Subscribe(uint(eventID), topics, viewerProof(ctx))
+	if err != nil {
+		if err.Error() == "event not found" {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: err.Error()})
+			return
+		}
+		ctx.JSON(http.StatusServiceUnavailable, ErrorResponse{Error: err.Error()})
+		return
+	}
+	defer c.service.Unsubscribe(sub)
+
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no")
+
+	heartbeat := time.NewTicker(liveHeartbeat)
+	defer heartbeat.Stop()
+
+	ctx.Stream(func(w io.Writer) bool {
+		select {
+		case <-ctx.Request.Context().Done():
+			return false
+		case <-heartbeat.C:
+			ctx.SSEvent("ping", gin.H{"at": time.Now()})
+		case <-sub.Notify():
+			for _, update := range sub.Drain() {
+				ctx.SSEvent(string(update.Topic), update)
+			}
+		}
+		return true
+	})
+}
+
+func parseLiveTopics(raw string) ([]services.LiveTopic, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+
+	known := make(map[services.LiveTopic]bool, len(services.AllLiveTopics))
+	for _, topic := range services.AllLiveTopics {
+		known[topic] = true
+	}
+
+	var topics []services.LiveTopic
+	for _, part := range strings.Split(raw, ",") {
+		topic := services.LiveTopic(strings.TrimSpace(part))
+		if topic == "" {
+			continue
+		}
+		if !known[topic] {
+			return nil, errors.New("unknown topic: " + string(topic))
+		}
+		topics = append(topics, topic)
+	}
+	return topics, nil
+}
+
+// viewerProof reads the optional organizer proof used to see hidden tallies
+// from the viewer_address, viewer_signature and viewer_timestamp query
+// parameters. It returns nil when no signature is given.
+func viewerProof(ctx *gin.Context) *services.ViewerProof {
+	signature := ctx.Query("viewer_signature")
+	if signature == "" {
+		return nil
+	}
+	timestamp, _ := strconv.ParseInt(ctx.Query("viewer_timestamp"), 10, 64)
+	return &services.ViewerProof{
+		Address:   ctx.Query("viewer_address"),
+		Signature: signature,
+		Timestamp: timestamp,
+	}
+}
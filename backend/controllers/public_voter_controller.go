@@ -0,0 +1,158 @@
+package controllers
+
+import (
+	"encoding/csv"
+	"errors"
+	"hackathon-platform/backend/repositories"
+	"hackathon-platform/backend/services"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// maxAllowlistFileBytes bounds an uploaded allowlist file.
+const maxAllowlistFileBytes = 2 << 20
+
+// PublicVoterController wires HTTP handlers to the public voter eligibility service.
+type PublicVoterController struct {
+	service services.PublicVoterService
+}
+
+// NewPublicVoterController builds a PublicVoterController with all dependencies.
+func NewPublicVoterController(db *gorm.DB, personhood services.PersonhoodVerifier) *PublicVoterController {
+	return &PublicVoterController{service: newPublicVoterService(db, personhood)}
+}
+
+func newPublicVoterService(db *gorm.DB, personhood services.PersonhoodVerifier) services.PublicVoterService {
+	return services.NewPublicVoterService(
+		repositories.NewEventRepository(db),
+		repositories.NewRegistrationRepository(db),
+		repositories.NewCheckInRepository(db),
+		repositories.NewPublicVoterAllowlistRepository(db),
+		personhood,
+	)
+}
+
+// CheckEligibility handles GET /events/:eventId/public-voters/eligibility
+func (c *PublicVoterController) CheckEligibility(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid event ID"})
+		return
+	}
+
+	voterAddress := ctx.Query("voter_address")
+	if voterAddress == "" {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "voter_address is required"})
+		return
+	}
+
+	report, err := c.service.CheckEligibility(uint(eventID), voterAddress, ctx.Query("offchain_proof"))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "event not found"})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, report)
+}
+
+// ListAllowlist handles GET /events/:eventId/public-voters/allowlist
+func (c *PublicVoterController) ListAllowlist(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid event ID"})
+		return
+	}
+
+	organizerAddress := ctx.Query("organizer_address")
+	if organizerAddress == "" {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "organizer_address is required"})
+		return
+	}
+
+	entries, err := c.service.ListAllowlist(uint(eventID), organizerAddress)
+	if err != nil {
+		ctx.JSON(http.StatusForbidden, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, entries)
+}
+
+// UploadAllowlist handles PUT /events/:eventId/public-voters/allowlist. The
+// addresses come either as JSON or as a multipart "file" part holding one
+// address per line (extra CSV columns are ignored).
+func (c *PublicVoterController) UploadAllowlist(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid event ID"})
+		return
+	}
+
+	var req services.UploadAllowlistRequest
+	if ctx.ContentType() == "multipart/form-data" {
+		ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxAllowlistFileBytes)
+		req.OrganizerAddress = ctx.PostForm("organizer_address")
+		req.Replace, _ = strconv.ParseBool(ctx.PostForm("replace"))
+		if req.OrganizerAddress == "" {
+			ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "organizer_address is required"})
+			return
+		}
+		file, err := ctx.FormFile("file")
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "missing file part"})
+			return
+		}
+		content, err := file.Open()
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+			return
+		}
+		defer content.Close()
+		if req.Addresses, err = readAllowlistFile(content); err != nil {
+			ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+			return
+		}
+	} else if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	result, err := c.service.UploadAllowlist(uint(eventID), &req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, result)
+}
+
+// readAllowlistFile takes the first column of every non-blank line. A
+// leading header row such as "address" is skipped.
+func readAllowlistFile(r io.Reader) ([]string, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var addresses []string
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return addresses, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		address := strings.TrimSpace(record[0])
+		if address == "" || (line == 1 && !common.IsHexAddress(address)) {
+			continue
+		}
+		addresses = append(addresses, address)
+	}
+}
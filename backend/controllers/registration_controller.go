@@ -15,11 +15,11 @@ type RegistrationController struct {
 	service services.RegistrationService
 }
 
-func NewRegistrationController(db *gorm.DB) *RegistrationController {
+func NewRegistrationController(db *gorm.DB, live services.LiveNotifier) *RegistrationController {
 	registrationRepo := repositories.NewRegistrationRepository(db)
 	teamRepo := repositories.NewTeamRepository(db)
 	eventRepo := repositories.NewEventRepository(db)
-	service := services.NewRegistrationService(registrationRepo, teamRepo, eventRepo)
+	service := services.NewRegistrationService(registrationRepo, teamRepo, eventRepo, live)
 	return &RegistrationController{service: service}
 }
 
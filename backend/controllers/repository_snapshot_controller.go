@@ -0,0 +1,119 @@
+package controllers
+
+import (
+	"errors"
+	"fmt"
+	"hackathon-platform/backend/repositories"
+	"hackathon-platform/backend/services"
+	"hackathon-platform/backend/storage"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type RepositorySnapshotController struct {
+	service services.RepositorySnapshotService
+}
+
+// NewRepositorySnapshotService builds the snapshot service shared by the
+// scheduled job and the HTTP endpoints. fetcher may be nil when git is not
+// available.
+func NewRepositorySnapshotService(db *gorm.DB, store storage.BlobStore, fetcher services.GitFetcher, maxBytes int64) services.RepositorySnapshotService {
+	return services.NewRepositorySnapshotService(
+		repositories.NewRepositorySnapshotRepository(db),
+		repositories.NewSubmissionRepository(db),
+		repositories.NewEventRepository(db),
+		repositories.NewSubmissionExtensionRepository(db),
+		store,
+		fetcher,
+		maxBytes,
+	)
+}
+
+func NewRepositorySnapshotController(service services.RepositorySnapshotService) *RepositorySnapshotController {
+	return &RepositorySnapshotController{service: service}
+}
+
+// SnapshotSubmission handles POST /submissions/:id/repo-snapshots
+func (c *RepositorySnapshotController) SnapshotSubmission(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid submission ID"})
+		return
+	}
+
+	var req struct {
+		OrganizerAddress string `json:"organizer_address" binding:"required"`
+	}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	snapshot, err := c.service.SnapshotSubmission(uint(id), req.OrganizerAddress)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "Submission not found"})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, snapshot)
+}
+
+// ListSnapshots handles GET /submissions/:id/repo-snapshots
+func (c *RepositorySnapshotController) ListSnapshots(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid submission ID"})
+		return
+	}
+
+	snapshots, err := c.service.ListSnapshots(uint(id))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "Submission not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, snapshots)
+}
+
+// DownloadArchive handles GET /submissions/:id/repo-snapshots/:snapshotId/archive
+func (c *RepositorySnapshotController) DownloadArchive(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid submission ID"})
+		return
+	}
+	snapshotID, err := strconv.ParseUint(ctx.Param("snapshotId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid snapshot ID"})
+		return
+	}
+
+	content, snapshot, err := c.service.OpenArchive(uint(id), uint(snapshotID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) || errors.Is(err, storage.ErrBlobNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "Snapshot archive not found"})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+	defer content.Close()
+
+	fileName := fmt.Sprintf("submission-%d-%s.tar.gz", snapshot.SubmissionID, snapshot.CommitSHA)
+	ctx.Header("ETag", `"`+snapshot.ArchiveCID+`"`)
+	ctx.Header("Cache-Control", "public, max-age=31536000, immutable")
+	ctx.Header("X-Content-Type-Options", "nosniff")
+	ctx.Header("Content-Disposition", "attachment; filename="+strconv.Quote(fileName))
+	ctx.DataFromReader(http.StatusOK, snapshot.ArchiveSize, "application/gzip", content, nil)
+}
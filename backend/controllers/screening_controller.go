@@ -0,0 +1,201 @@
+package controllers
+
+import (
+	"errors"
+	"hackathon-platform/backend/repositories"
+	"hackathon-platform/backend/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type ScreeningController struct {
+	service services.ScreeningService
+}
+
+func NewScreeningController(db *gorm.DB, live services.LiveNotifier) *ScreeningController {
+	screeningRepo := repositories.NewScreeningRepository(db)
+	submissionRepo := repositories.NewSubmissionRepository(db)
+	eventRepo := repositories.NewEventRepository(db)
+	teamRepo := repositories.NewTeamRepository(db)
+	statusRepo := repositories.NewSubmissionStatusChangeRepository(db)
+	service := services.NewScreeningService(screeningRepo, submissionRepo, eventRepo, teamRepo, statusRepo, live)
+	return &ScreeningController{service: service}
+}
+
+// GetPolicy returns an event's screening policy
+func (c *ScreeningController) GetPolicy(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid event ID"})
+		return
+	}
+
+	policy, err := c.service.GetPolicy(uint(eventID))
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, policy)
+}
+
+// SetPolicy configures reviewers per submission, quorum and decision rule
+func (c *ScreeningController) SetPolicy(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid event ID"})
+		return
+	}
+
+	var req services.SetScreeningPolicyRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	policy, err := c.service.SetPolicy(uint(eventID), &req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, policy)
+}
+
+// AssignReviewers spreads reviewers over an event's pending submissions
+func (c *ScreeningController) AssignReviewers(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid event ID"})
+		return
+	}
+
+	var req services.AssignReviewersRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	result, err := c.service.AssignReviewers(uint(eventID), &req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// RemoveAssignment unassigns a reviewer from a submission
+func (c *ScreeningController) RemoveAssignment(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid submission ID"})
+		return
+	}
+	assignmentID, err := strconv.ParseUint(ctx.Param("assignmentId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid assignment ID"})
+		return
+	}
+
+	organizerAddress := ctx.Query("organizer_address")
+	if organizerAddress == "" {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "organizer_address is required"})
+		return
+	}
+
+	if err := c.service.RemoveAssignment(uint(id), uint(assignmentID), organizerAddress); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "Assignment not found"})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Reviewer removed successfully"})
+}
+
+// SubmitVerdict records an assigned reviewer's eligibility verdict
+func (c *ScreeningController) SubmitVerdict(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid submission ID"})
+		return
+	}
+
+	var req services.ScreeningVerdictRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	status, err := c.service.SubmitVerdict(uint(id), &req)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "Submission not found"})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, status)
+}
+
+// GetStatus returns a submission's screening tally and audit trail
+func (c *ScreeningController) GetStatus(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid submission ID"})
+		return
+	}
+
+	organizerAddress := ctx.Query("organizer_address")
+	if organizerAddress == "" {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "organizer_address is required"})
+		return
+	}
+
+	status, err := c.service.GetStatus(uint(id), organizerAddress)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "Submission not found"})
+			return
+		}
+		ctx.JSON(http.StatusForbidden, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, status)
+}
+
+// ListQueue returns the submissions waiting for a reviewer's verdict
+func (c *ScreeningController) ListQueue(ctx *gin.Context) {
+	reviewerAddress := ctx.Query("reviewer_address")
+	if reviewerAddress == "" {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "reviewer_address is required"})
+		return
+	}
+
+	var eventID uint64
+	if raw := ctx.Query("event_id"); raw != "" {
+		var err error
+		eventID, err = strconv.ParseUint(raw, 10, 32)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid event ID"})
+			return
+		}
+	}
+
+	queue, err := c.service.ListQueue(reviewerAddress, uint(eventID))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, queue)
+}
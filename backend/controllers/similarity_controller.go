@@ -0,0 +1,63 @@
+package controllers
+
+import (
+	"hackathon-platform/backend/repositories"
+	"hackathon-platform/backend/services"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type SimilarityController struct {
+	service services.SimilarityService
+}
+
+func NewSimilarityController(db *gorm.DB) *SimilarityController {
+	submissionRepo := repositories.NewSubmissionRepository(db)
+	eventRepo := repositories.NewEventRepository(db)
+	service := services.NewSimilarityService(submissionRepo, eventRepo)
+	return &SimilarityController{service: service}
+}
+
+// GetEventReport handles GET /submissions/event/:eventId/similarity
+func (c *SimilarityController) GetEventReport(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid event ID"})
+		return
+	}
+
+	organizerAddress := ctx.Query("organizer_address")
+	if organizerAddress == "" {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "organizer_address is required"})
+		return
+	}
+
+	threshold := services.DefaultSimilarityThreshold
+	if raw := ctx.Query("threshold"); raw != "" {
+		threshold, err = strconv.ParseFloat(raw, 64)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid threshold"})
+			return
+		}
+	}
+
+	includePast := true
+	if raw := ctx.Query("include_past"); raw != "" {
+		includePast, err = strconv.ParseBool(raw)
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid include_past"})
+			return
+		}
+	}
+
+	report, err := c.service.GetEventReport(uint(eventID), organizerAddress, threshold, includePast)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, report)
+}
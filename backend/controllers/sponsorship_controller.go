@@ -19,7 +19,8 @@ func NewSponsorshipController(db *gorm.DB) *SponsorshipController {
 	sponsorshipRepo := repositories.NewSponsorshipRepository(db)
 	eventRepo := repositories.NewEventRepository(db)
 	sponsorRepo := repositories.NewSponsorRepository(db)
-	service := services.NewSponsorshipService(sponsorshipRepo, eventRepo, sponsorRepo)
+	ruleRepo := repositories.NewSponsorVotingRuleRepository(db)
+	service := services.NewSponsorshipService(sponsorshipRepo, eventRepo, sponsorRepo, ruleRepo)
 	return &SponsorshipController{service: service}
 }
 
@@ -153,3 +154,68 @@ func (c *SponsorshipController) UpdateDepositStatus(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, sponsorship)
 }
 
+
+// GetVotingRules lists the sponsor voting rules of an event
+func (c *SponsorshipController) GetVotingRules(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid event ID"})
+		return
+	}
+
+	rules, err := c.service.GetVotingRules(uint(eventID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "Event not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rules)
+}
+
+// SetVotingRules replaces the sponsor voting rules of an event
+func (c *SponsorshipController) SetVotingRules(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid event ID"})
+		return
+	}
+
+	var req services.SetSponsorVotingRulesRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	rules, err := c.service.SetVotingRules(uint(eventID), &req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, rules)
+}
+
+// GetVotingPower shows how each sponsor's voting power is computed
+func (c *SponsorshipController) GetVotingPower(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid event ID"})
+		return
+	}
+
+	powers, err := c.service.GetVotingPower(uint(eventID), ctx.Query("sponsor_address"))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "Event not found"})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, powers)
+}
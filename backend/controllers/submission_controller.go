@@ -15,11 +15,39 @@ type SubmissionController struct {
 	service services.SubmissionService
 }
 
-func NewSubmissionController(db *gorm.DB) *SubmissionController {
+// ValidationErrorResponse lists the fields that fail the event's
+// submission requirements.
+type ValidationErrorResponse struct {
+	Error  string                `json:"error"`
+	Fields []services.FieldError `json:"fields"`
+}
+
+func NewSubmissionController(db *gorm.DB, live services.LiveNotifier) *SubmissionController {
 	submissionRepo := repositories.NewSubmissionRepository(db)
 	eventRepo := repositories.NewEventRepository(db)
 	teamRepo := repositories.NewTeamRepository(db)
-	service := services.NewSubmissionService(submissionRepo, eventRepo, teamRepo)
+	uploadRepo := repositories.NewUploadedFileRepository(db)
+	revisionRepo := repositories.NewSubmissionRevisionRepository(db)
+	extensionRepo := repositories.NewSubmissionExtensionRepository(db)
+	anchorRepo := repositories.NewSubmissionAnchorRepository(db)
+	signoffRepo := repositories.NewSubmissionSignoffRepository(db)
+	statusRepo := repositories.NewSubmissionStatusChangeRepository(db)
+	requirementsRepo := repositories.NewSubmissionRequirementsRepository(db)
+	screeningRepo := repositories.NewScreeningRepository(db)
+	service := services.NewSubmissionService(
+		submissionRepo,
+		eventRepo,
+		teamRepo,
+		uploadRepo,
+		revisionRepo,
+		extensionRepo,
+		anchorRepo,
+		signoffRepo,
+		statusRepo,
+		requirementsRepo,
+		screeningRepo,
+		live,
+	)
 	return &SubmissionController{service: service}
 }
 
@@ -33,6 +61,9 @@ func (c *SubmissionController) CreateSubmission(ctx *gin.Context) {
 
 	submission, err := c.service.CreateSubmission(&req)
 	if err != nil {
+		if respondValidationError(ctx, err) {
+			return
+		}
 		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
 		return
 	}
@@ -105,6 +136,9 @@ func (c *SubmissionController) UpdateSubmission(ctx *gin.Context) {
 
 	submission, err := c.service.UpdateSubmission(uint(id), &req)
 	if err != nil {
+		if respondValidationError(ctx, err) {
+			return
+		}
 		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
 		return
 	}
@@ -123,13 +157,14 @@ func (c *SubmissionController) ApproveSubmission(ctx *gin.Context) {
 	var req struct {
 		OrganizerAddress string `json:"organizer_address" binding:"required"`
 		Comment          string `json:"comment"`
+		Override         bool   `json:"override"` // Decide despite reviewer screening; logged to the screening audit trail
 	}
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
 		return
 	}
 
-	submission, err := c.service.ApproveSubmission(uint(id), req.OrganizerAddress, req.Comment)
+	submission, err := c.service.ApproveSubmission(uint(id), req.OrganizerAddress, req.Comment, req.Override)
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
 		return
@@ -149,13 +184,15 @@ func (c *SubmissionController) RejectSubmission(ctx *gin.Context) {
 	var req struct {
 		OrganizerAddress string `json:"organizer_address" binding:"required"`
 		Comment          string `json:"comment"`
+		RequestChanges   bool   `json:"request_changes"` // Reopen the submission for editing instead of a final rejection
+		Override         bool   `json:"override"`        // Decide despite reviewer screening; logged to the screening audit trail
 	}
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
 		return
 	}
 
-	submission, err := c.service.RejectSubmission(uint(id), req.OrganizerAddress, req.Comment)
+	submission, err := c.service.RejectSubmission(uint(id), req.OrganizerAddress, req.Comment, req.RequestChanges, req.Override)
 	if err != nil {
 		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
 		return
@@ -172,15 +209,414 @@ func (c *SubmissionController) DeleteSubmission(ctx *gin.Context) {
 		return
 	}
 
-	err = c.service.DeleteSubmission(uint(id))
+	requesterAddress := ctx.Query("requester_address")
+	if requesterAddress == "" {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "requester_address is required"})
+		return
+	}
+
+	err = c.service.DeleteSubmission(uint(id), requesterAddress)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "Submission not found"})
 			return
 		}
-		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
 		return
 	}
 
 	ctx.JSON(http.StatusOK, gin.H{"message": "Submission deleted successfully"})
 }
+
+// WithdrawSubmission lets a team member withdraw a submission
+func (c *SubmissionController) WithdrawSubmission(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid submission ID"})
+		return
+	}
+
+	var req struct {
+		MemberAddress string `json:"member_address" binding:"required"`
+		Reason        string `json:"reason"`
+	}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	submission, err := c.service.WithdrawSubmission(uint(id), req.MemberAddress, req.Reason)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "Submission not found"})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, submission)
+}
+
+// GetStatusHistory returns every status transition of a submission, oldest first
+func (c *SubmissionController) GetStatusHistory(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid submission ID"})
+		return
+	}
+
+	history, err := c.service.GetStatusHistory(uint(id))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "Submission not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, history)
+}
+
+// FinalizeSubmission submits a team's draft
+func (c *SubmissionController) FinalizeSubmission(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid submission ID"})
+		return
+	}
+
+	var req struct {
+		SubmittedBy string `json:"submitted_by" binding:"required"`
+	}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	submission, err := c.service.FinalizeSubmission(uint(id), req.SubmittedBy)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "Submission not found"})
+			return
+		}
+		if respondValidationError(ctx, err) {
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, submission)
+}
+
+// SignOffSubmission records a team member's approval of the current content
+func (c *SubmissionController) SignOffSubmission(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid submission ID"})
+		return
+	}
+
+	var req struct {
+		MemberAddress string `json:"member_address" binding:"required"`
+	}
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	status, err := c.service.SignOffSubmission(uint(id), req.MemberAddress)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "Submission not found"})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, status)
+}
+
+// GetSignoffStatus shows which members have signed off the current content
+func (c *SubmissionController) GetSignoffStatus(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid submission ID"})
+		return
+	}
+
+	status, err := c.service.GetSignoffStatus(uint(id))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "Submission not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, status)
+}
+
+// ListRevisions returns every revision of a submission, oldest first
+func (c *SubmissionController) ListRevisions(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid submission ID"})
+		return
+	}
+
+	revisions, err := c.service.ListRevisions(uint(id))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "Submission not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, revisions)
+}
+
+// GetRevision returns a single revision by number
+func (c *SubmissionController) GetRevision(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid submission ID"})
+		return
+	}
+	number, err := strconv.Atoi(ctx.Param("revision"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid revision number"})
+		return
+	}
+
+	revision, err := c.service.GetRevision(uint(id), number)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "Revision not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, revision)
+}
+
+// DiffRevisions compares two revisions given by the from and to query params
+func (c *SubmissionController) DiffRevisions(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid submission ID"})
+		return
+	}
+	from, err := strconv.Atoi(ctx.Query("from"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid from revision"})
+		return
+	}
+	to, err := strconv.Atoi(ctx.Query("to"))
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid to revision"})
+		return
+	}
+
+	diff, err := c.service.DiffRevisions(uint(id), from, to)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, diff)
+}
+
+// GetRevisionAtDeadline returns the revision current at the submission deadline
+func (c *SubmissionController) GetRevisionAtDeadline(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid submission ID"})
+		return
+	}
+
+	revision, err := c.service.GetRevisionAtDeadline(uint(id))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "Submission not found"})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, revision)
+}
+
+// GetFingerprint returns the Merkle fingerprint of a submission with proofs
+func (c *SubmissionController) GetFingerprint(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid submission ID"})
+		return
+	}
+
+	fingerprint, err := c.service.GetFingerprint(uint(id))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "Submission not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, fingerprint)
+}
+
+// VerifySubmission checks submitted content or an inclusion proof against
+// the stored submission hash
+func (c *SubmissionController) VerifySubmission(ctx *gin.Context) {
+	var req services.VerifySubmissionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	result, err := c.service.VerifySubmission(&req)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "Submission not found"})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// GrantExtension gives a team a later submission deadline
+func (c *SubmissionController) GrantExtension(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid event ID"})
+		return
+	}
+
+	var req services.GrantExtensionRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	extension, err := c.service.GrantExtension(uint(eventID), &req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, extension)
+}
+
+// ListExtensions returns the deadline extensions granted for an event
+func (c *SubmissionController) ListExtensions(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid event ID"})
+		return
+	}
+
+	extensions, err := c.service.ListExtensions(uint(eventID))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, extensions)
+}
+
+// RevokeExtension removes a deadline extension
+func (c *SubmissionController) RevokeExtension(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid event ID"})
+		return
+	}
+	extensionID, err := strconv.ParseUint(ctx.Param("extensionId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid extension ID"})
+		return
+	}
+
+	organizerAddress := ctx.Query("organizer_address")
+	if organizerAddress == "" {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "organizer_address is required"})
+		return
+	}
+
+	if err := c.service.RevokeExtension(uint(eventID), uint(extensionID), organizerAddress); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "Extension not found"})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, gin.H{"message": "Extension revoked successfully"})
+}
+
+// GetRequirements returns the fields and declarations an event requires
+func (c *SubmissionController) GetRequirements(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid event ID"})
+		return
+	}
+
+	requirements, err := c.service.GetRequirements(uint(eventID))
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, requirements)
+}
+
+// SetRequirements replaces an event's submission requirements
+func (c *SubmissionController) SetRequirements(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "Invalid event ID"})
+		return
+	}
+
+	var req services.SetSubmissionRequirementsRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	requirements, err := c.service.SetRequirements(uint(eventID), &req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, requirements)
+}
+
+// respondValidationError writes a 422 with per-field errors when err is a
+// requirements failure.
+func respondValidationError(ctx *gin.Context, err error) bool {
+	var invalid *services.SubmissionValidationError
+	if !errors.As(err, &invalid) {
+		return false
+	}
+	ctx.JSON(http.StatusUnprocessableEntity, ValidationErrorResponse{Error: invalid.Error(), Fields: invalid.Fields})
+	return true
+}
@@ -0,0 +1,134 @@
+package controllers
+
+import (
+	"errors"
+	"hackathon-platform/backend/repositories"
+	"hackathon-platform/backend/services"
+	"hackathon-platform/backend/storage"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// maxUploadFieldBytes bounds the non-file form fields read before the file.
+const maxUploadFieldBytes = 1024
+
+type UploadController struct {
+	service services.UploadService
+}
+
+func NewUploadController(db *gorm.DB, store storage.BlobStore, defaultLimit int64) *UploadController {
+	uploadRepo := repositories.NewUploadedFileRepository(db)
+	eventRepo := repositories.NewEventRepository(db)
+	service := services.NewUploadService(uploadRepo, eventRepo, store, defaultLimit)
+	return &UploadController{service: service}
+}
+
+// Upload handles POST /uploads/event/:eventId as a streamed multipart upload.
+// The uploaded_by field must precede the file part unless it is given as a
+// query parameter.
+func (c *UploadController) Upload(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid event ID"})
+		return
+	}
+
+	reader, err := ctx.Request.MultipartReader()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "expected multipart/form-data body"})
+		return
+	}
+
+	req := services.UploadRequest{UploadedBy: ctx.Query("uploaded_by")}
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "missing file part"})
+			return
+		}
+		if err != nil {
+			ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		if part.FormName() != "file" {
+			value, err := io.ReadAll(io.LimitReader(part, maxUploadFieldBytes))
+			if err != nil {
+				ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+				return
+			}
+			if part.FormName() == "uploaded_by" {
+				req.UploadedBy = string(value)
+			}
+			continue
+		}
+
+		req.FileName = part.FileName()
+		upload, err := c.service.Upload(uint(eventID), &req, part)
+		if err != nil {
+			switch {
+			case errors.Is(err, services.ErrUploadTooLarge):
+				ctx.JSON(http.StatusRequestEntityTooLarge, ErrorResponse{Error: err.Error()})
+			case errors.Is(err, services.ErrUploadTypeForbidden):
+				ctx.JSON(http.StatusUnsupportedMediaType, ErrorResponse{Error: err.Error()})
+			default:
+				ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+			}
+			return
+		}
+
+		ctx.JSON(http.StatusCreated, upload)
+		return
+	}
+}
+
+// ListByEvent handles GET /uploads/event/:eventId
+func (c *UploadController) ListByEvent(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid event ID"})
+		return
+	}
+
+	uploads, err := c.service.ListByEvent(uint(eventID))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, uploads)
+}
+
+// Download handles GET /uploads/:cid. Content is immutable, so responses are
+// cacheable forever and the CID doubles as the ETag.
+func (c *UploadController) Download(ctx *gin.Context) {
+	cid := ctx.Param("cid")
+	content, upload, err := c.service.Open(cid)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) || errors.Is(err, storage.ErrBlobNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "file not found"})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+	defer content.Close()
+
+	etag := `"` + upload.CID + `"`
+	if ctx.GetHeader("If-None-Match") == etag {
+		ctx.Status(http.StatusNotModified)
+		return
+	}
+
+	ctx.Header("ETag", etag)
+	ctx.Header("Cache-Control", "public, max-age=31536000, immutable")
+	ctx.Header("X-Content-Type-Options", "nosniff")
+	// Uploaded content is untrusted; never let it script against the API origin
+	ctx.Header("Content-Security-Policy", "sandbox")
+	ctx.Header("Content-Disposition", "inline; filename="+strconv.Quote(upload.FileName))
+	ctx.DataFromReader(http.StatusOK, upload.Size, upload.ContentType, content, nil)
+}
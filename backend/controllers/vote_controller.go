@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"errors"
 	"hackathon-platform/backend/repositories"
 	"hackathon-platform/backend/services"
 	"net/http"
@@ -16,15 +17,26 @@ type VoteController struct {
 }
 
 // NewVoteController builds a VoteController with all dependencies.
-func NewVoteController(db *gorm.DB) *VoteController {
+func NewVoteController(db *gorm.DB, live services.LiveNotifier, personhood services.PersonhoodVerifier) *VoteController {
+	return &VoteController{service: newVoteService(db, live, personhood)}
+}
+
+func newVoteService(db *gorm.DB, live services.LiveNotifier, personhood services.PersonhoodVerifier) services.VoteService {
 	voteRepo := repositories.NewVoteRepository(db)
 	eventRepo := repositories.NewEventRepository(db)
 	submissionRepo := repositories.NewSubmissionRepository(db)
 	eventJudgeRepo := repositories.NewEventJudgeRepository(db)
+	rubricRepo := repositories.NewRubricRepository(db)
+	assignmentRepo := repositories.NewJudgeAssignmentRepository(db)
+	conflictRepo := repositories.NewJudgeConflictRepository(db)
+	commitmentRepo := repositories.NewVoteCommitmentRepository(db)
+	nonceRepo := repositories.NewVoteNonceRepository(db)
 	sponsorRepo := repositories.NewSponsorRepository(db)
 	sponsorshipRepo := repositories.NewSponsorshipRepository(db)
-	service := services.NewVoteService(voteRepo, eventRepo, submissionRepo, eventJudgeRepo, sponsorRepo, sponsorshipRepo)
-	return &VoteController{service: service}
+	sponsorRuleRepo := repositories.NewSponsorVotingRuleRepository(db)
+	publicVoters := newPublicVoterService(db, personhood)
+	attendance := newAttendanceService(db)
+	return services.NewVoteService(voteRepo, eventRepo, submissionRepo, eventJudgeRepo, rubricRepo, assignmentRepo, conflictRepo, commitmentRepo, nonceRepo, sponsorRepo, sponsorshipRepo, sponsorRuleRepo, publicVoters, attendance, live)
 }
 
 // CastVote handles POST /votes
@@ -51,8 +63,16 @@ func (c *VoteController) ListVotesByEvent(ctx *gin.Context) {
 		return
 	}
 
-	votes, err := c.service.ListVotesByEvent(uint(eventID))
+	votes, err := c.service.ListVotesByEvent(uint(eventID), viewerProof(ctx))
 	if err != nil {
+		if errors.Is(err, services.ErrTalliesHidden) {
+			ctx.JSON(http.StatusForbidden, ErrorResponse{Error: err.Error()})
+			return
+		}
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "event not found"})
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
 		return
 	}
@@ -67,8 +87,16 @@ func (c *VoteController) ListVotesBySubmission(ctx *gin.Context) {
 		return
 	}
 
-	votes, err := c.service.ListVotesBySubmission(uint(submissionID))
+	votes, err := c.service.ListVotesBySubmission(uint(submissionID), viewerProof(ctx))
 	if err != nil {
+		if errors.Is(err, services.ErrTalliesHidden) {
+			ctx.JSON(http.StatusForbidden, ErrorResponse{Error: err.Error()})
+			return
+		}
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "submission not found"})
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
 		return
 	}
@@ -91,6 +119,38 @@ func (c *VoteController) GetVote(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, vote)
 }
 
+// PrepareVote handles POST /votes/typed-data
+func (c *VoteController) PrepareVote(ctx *gin.Context) {
+	var req services.CastVoteRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	typedData, err := c.service.PrepareVote(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, typedData)
+}
+
+// VerifyVote handles GET /votes/:id/verify
+func (c *VoteController) VerifyVote(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid vote ID"})
+		return
+	}
+
+	verification, err := c.service.VerifyVote(uint(id))
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, verification)
+}
+
 // DeleteVote handles DELETE /votes/:id
 func (c *VoteController) DeleteVote(ctx *gin.Context) {
 	id, err := strconv.ParseUint(ctx.Param("id"), 10, 32)
@@ -129,14 +189,106 @@ func (c *VoteController) GetEventSummary(ctx *gin.Context) {
 		return
 	}
 
-	summary, err := c.service.GetEventSummary(uint(eventID))
+	summary, err := c.service.GetEventSummary(uint(eventID), viewerProof(ctx))
 	if err != nil {
+		if errors.Is(err, services.ErrTalliesHidden) {
+			ctx.JSON(http.StatusForbidden, ErrorResponse{Error: err.Error()})
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
 		return
 	}
 	ctx.JSON(http.StatusOK, summary)
 }
 
+// CommitVote handles POST /votes/commit
+func (c *VoteController) CommitVote(ctx *gin.Context) {
+	var req services.CommitVoteRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	commitment, err := c.service.CommitVote(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusCreated, commitment)
+}
+
+// PrepareCommitment handles POST /votes/commit/typed-data
+func (c *VoteController) PrepareCommitment(ctx *gin.Context) {
+	var req services.CommitVoteRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	typedData, err := c.service.PrepareCommitment(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, typedData)
+}
+
+// RevealVote handles POST /votes/reveal
+func (c *VoteController) RevealVote(ctx *gin.Context) {
+	var req services.RevealVoteRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	vote, err := c.service.RevealVote(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusCreated, vote)
+}
+
+// GetCommitStatus handles GET /votes/event/:eventId/commitments
+func (c *VoteController) GetCommitStatus(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid event ID"})
+		return
+	}
+
+	status, err := c.service.GetCommitStatus(uint(eventID), ctx.Query("voter_address"))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "event not found"})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, status)
+}
+
+// GetVoteCredits handles GET /votes/event/:eventId/credits
+func (c *VoteController) GetVoteCredits(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid event ID"})
+		return
+	}
+
+	credits, err := c.service.GetVoteCredits(uint(eventID), ctx.Query("voter_address"))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "event not found"})
+			return
+		}
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, credits)
+}
+
 // AddJudge handles POST /events/:eventId/judges
 func (c *VoteController) AddJudge(ctx *gin.Context) {
 	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
@@ -204,3 +356,122 @@ func (c *VoteController) RemoveJudge(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, gin.H{"message": "judge removed"})
 }
 
+// GetRubric handles GET /events/:eventId/rubric
+func (c *VoteController) GetRubric(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid event ID"})
+		return
+	}
+
+	criteria, err := c.service.GetRubric(uint(eventID))
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, criteria)
+}
+
+// SetRubric handles PUT /events/:eventId/rubric
+func (c *VoteController) SetRubric(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid event ID"})
+		return
+	}
+
+	var req services.SetRubricRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	criteria, err := c.service.SetRubric(uint(eventID), &req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, criteria)
+}
+
+// GetCalibration handles GET /events/:eventId/rubric/calibration
+func (c *VoteController) GetCalibration(ctx *gin.Context) {
+	eventID, err := strconv.ParseUint(ctx.Param("eventId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid event ID"})
+		return
+	}
+
+	organizerAddress := ctx.Query("organizer_address")
+	if organizerAddress == "" {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "organizer_address is required"})
+		return
+	}
+
+	report, err := c.service.GetCalibration(uint(eventID), organizerAddress)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "event not found"})
+			return
+		}
+		ctx.JSON(http.StatusForbidden, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, report)
+}
+
+// SubmitScorecard handles POST /votes/scores
+func (c *VoteController) SubmitScorecard(ctx *gin.Context) {
+	var req services.SubmitScorecardRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	scores, err := c.service.SubmitScorecard(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, scores)
+}
+
+// PrepareScorecard handles POST /votes/scores/typed-data
+func (c *VoteController) PrepareScorecard(ctx *gin.Context) {
+	var req services.SubmitScorecardRequest
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+
+	typedData, err := c.service.PrepareScorecard(&req)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, typedData)
+}
+
+// ListScorecards handles GET /votes/submission/:submissionId/scores
+func (c *VoteController) ListScorecards(ctx *gin.Context) {
+	submissionID, err := strconv.ParseUint(ctx.Param("submissionId"), 10, 32)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, ErrorResponse{Error: "invalid submission ID"})
+		return
+	}
+
+	scores, err := c.service.ListScorecards(uint(submissionID), viewerProof(ctx))
+	if err != nil {
+		if errors.Is(err, services.ErrTalliesHidden) {
+			ctx.JSON(http.StatusForbidden, ErrorResponse{Error: err.Error()})
+			return
+		}
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			ctx.JSON(http.StatusNotFound, ErrorResponse{Error: "submission not found"})
+			return
+		}
+		ctx.JSON(http.StatusInternalServerError, ErrorResponse{Error: err.Error()})
+		return
+	}
+	ctx.JSON(http.StatusOK, scores)
+}
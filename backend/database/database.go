@@ -33,10 +33,36 @@ func Initialize(databaseURL string) *gorm.DB {
 		&models.TeamMember{},
 		&models.Registration{},
 		&models.CheckIn{},
+		&models.CheckInKiosk{},
+		&models.AttendanceSession{},
+		&models.SessionAttendance{},
 		&models.Submission{},
 		&models.SubmissionFile{},
+		&models.SubmissionRevision{},
+		&models.SubmissionDeadlineExtension{},
+		&models.SubmissionSignoff{},
+		&models.SubmissionStatusChange{},
+		&models.SubmissionRequirements{},
+		&models.ScreeningPolicy{},
+		&models.ScreeningAssignment{},
+		&models.ScreeningVerdict{},
+		&models.SubmissionAnchor{},
+		&models.SubmissionAnchorProof{},
+		&models.RepositorySnapshot{},
+		&models.UploadedFile{},
 		&models.Vote{},
 		&models.EventJudge{},
+		&models.RubricCriterion{},
+		&models.RubricScore{},
+		&models.JudgeAssignment{},
+		&models.JudgeConflict{},
+		&models.Ballot{},
+		&models.BallotRanking{},
+		&models.VoteCommitment{},
+		&models.PublicVoterAllowlistEntry{},
+		&models.VoteNonce{},
+		&models.VoteCreditAccount{},
+		&models.SponsorVotingRule{},
 	)
 
 	if err != nil {
@@ -4,6 +4,10 @@ import (
 	"hackathon-platform/backend/config"
 	"hackathon-platform/backend/controllers"
 	"hackathon-platform/backend/database"
+	"hackathon-platform/backend/services"
+	"hackathon-platform/backend/storage"
+	"log"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -34,16 +38,80 @@ func main() {
 		c.Next()
 	})
 
+	// Blob store for uploaded submission files
+	blobStore, err := storage.NewLocalStore(cfg.UploadDir)
+	if err != nil {
+		panic("Failed to initialize upload storage: " + err.Error())
+	}
+
+	// Live updates are shared by every controller that publishes changes
+	liveService := controllers.NewLiveService(db)
+
+	// Submission roots are anchored on-chain when an RPC endpoint and key are configured
+	var anchorer services.Anchorer
+	if cfg.AnchorRPCURL != "" && cfg.AnchorPrivateKey != "" {
+		anchorer, err = services.NewEthAnchorer(cfg.AnchorRPCURL, cfg.AnchorPrivateKey, cfg.AnchorContract)
+		if err != nil {
+			log.Printf("On-chain anchoring disabled: %v", err)
+			anchorer = nil
+		}
+	}
+	anchorService := controllers.NewAnchorService(db, anchorer)
+	go func() {
+		ticker := time.NewTicker(cfg.AnchorInterval)
+		defer ticker.Stop()
+		for now := range ticker.C {
+			anchorService.AnchorDue(now)
+		}
+	}()
+
+	// Submission repositories are snapshotted after the deadline when git is available
+	var gitFetcher services.GitFetcher
+	gitFetcher, err = services.NewExecGitFetcher(cfg.GitBinary, cfg.GitAllowProtocols)
+	if err != nil {
+		log.Printf("Repository snapshots disabled: %v", err)
+		gitFetcher = nil
+	}
+	snapshotService := controllers.NewRepositorySnapshotService(db, blobStore, gitFetcher, cfg.GitSnapshotMaxBytes)
+	go func() {
+		ticker := time.NewTicker(cfg.GitSnapshotInterval)
+		defer ticker.Stop()
+		for now := range ticker.C {
+			snapshotService.SnapshotDue(now)
+		}
+	}()
+
+	// Public voters can be required to prove personhood when a verifier is configured
+	var personhood services.PersonhoodVerifier
+	if cfg.PersonhoodVerifierURL != "" {
+		personhood, err = services.NewHTTPPersonhoodVerifier(cfg.PersonhoodVerifierURL)
+		if err != nil {
+			log.Printf("Personhood verification disabled: %v", err)
+			personhood = nil
+		}
+	}
+
 	// Initialize controllers
 	eventController := controllers.NewEventController(db)
 	sponsorController := controllers.NewSponsorController(db)
 	sponsorshipController := controllers.NewSponsorshipController(db)
 	fundingPoolController := controllers.NewFundingPoolController(db)
 	teamController := controllers.NewTeamController(db)
-	registrationController := controllers.NewRegistrationController(db)
-	checkInController := controllers.NewCheckInController(db)
-	submissionController := controllers.NewSubmissionController(db)
-	voteController := controllers.NewVoteController(db)
+	registrationController := controllers.NewRegistrationController(db, liveService)
+	checkInController := controllers.NewCheckInController(db, liveService)
+	submissionController := controllers.NewSubmissionController(db, liveService)
+	voteController := controllers.NewVoteController(db, liveService, personhood)
+	ballotController := controllers.NewBallotController(db, liveService, personhood)
+	attendanceController := controllers.NewAttendanceController(db)
+	liveController := controllers.NewLiveController(liveService)
+	uploadController := controllers.NewUploadController(db, blobStore, cfg.MaxUploadBytes)
+	anchorController := controllers.NewAnchorController(anchorService)
+	similarityController := controllers.NewSimilarityController(db)
+	screeningController := controllers.NewScreeningController(db, liveService)
+	judgeAssignmentController := controllers.NewJudgeAssignmentController(db)
+	conflictController := controllers.NewConflictController(db)
+	publicVoterController := controllers.NewPublicVoterController(db, personhood)
+	snapshotController := controllers.NewRepositorySnapshotController(snapshotService)
 
 	// API routes
 	api := r.Group("/api/v1")
@@ -60,6 +128,23 @@ func main() {
 			events.GET("/:eventId/judges", voteController.ListJudges)
 			events.POST("/:eventId/judges", voteController.AddJudge)
 			events.DELETE("/:eventId/judges/:judgeId", voteController.RemoveJudge)
+			events.GET("/:eventId/rubric", voteController.GetRubric)
+			events.PUT("/:eventId/rubric", voteController.SetRubric)
+			events.GET("/:eventId/rubric/calibration", voteController.GetCalibration)
+			events.GET("/:eventId/sponsor-voting-rules", sponsorshipController.GetVotingRules)
+			events.PUT("/:eventId/sponsor-voting-rules", sponsorshipController.SetVotingRules)
+			events.GET("/:eventId/judge-assignments", judgeAssignmentController.ListAssignments)
+			events.POST("/:eventId/judge-assignments", judgeAssignmentController.AssignJudges)
+			events.POST("/:eventId/judge-assignments/reassign", judgeAssignmentController.ReassignJudge)
+			events.GET("/:eventId/judge-assignments/queue", judgeAssignmentController.GetQueue)
+			events.GET("/:eventId/conflicts", conflictController.ListConflicts)
+			events.POST("/:eventId/conflicts", conflictController.DeclareConflict)
+			events.DELETE("/:eventId/conflicts/:conflictId", conflictController.RemoveConflict)
+			events.GET("/:eventId/conflicts/violations", conflictController.GetViolations)
+			events.GET("/:eventId/public-voters/eligibility", publicVoterController.CheckEligibility)
+			events.GET("/:eventId/public-voters/allowlist", publicVoterController.ListAllowlist)
+			events.PUT("/:eventId/public-voters/allowlist", publicVoterController.UploadAllowlist)
+			events.GET("/:eventId/live", liveController.Stream)
 		}
 
 		// Sponsors
@@ -78,6 +163,7 @@ func main() {
 		{
 			sponsorships.POST("", sponsorshipController.CreateSponsorship)
 			sponsorships.GET("/event/:eventId", sponsorshipController.ListSponsorshipsByEvent)
+			sponsorships.GET("/event/:eventId/voting-power", sponsorshipController.GetVotingPower)
 			sponsorships.GET("/:id", sponsorshipController.GetSponsorship)
 			sponsorships.PATCH("/:id/approve", sponsorshipController.ApproveSponsorship)
 			sponsorships.PATCH("/:id/reject", sponsorshipController.RejectSponsorship)
@@ -129,14 +215,32 @@ func main() {
 		{
 			checkIns.GET("/event/:eventId/qrcode", checkInController.GenerateQRCode)
 			checkIns.POST("", checkInController.CheckIn)
+			checkIns.POST("/kiosk-sync", checkInController.SyncKioskBatch)
 			checkIns.GET("/event/:eventId", checkInController.ListCheckInsByEvent)
 			checkIns.GET("/event/:eventId/count", checkInController.GetCheckInCount)
 			checkIns.GET("/event/:eventId/user/:address", checkInController.GetUserCheckIn)
+			checkIns.GET("/event/:eventId/kiosks", checkInController.ListKiosks)
+			checkIns.POST("/event/:eventId/kiosks", checkInController.AuthorizeKiosk)
+			checkIns.DELETE("/event/:eventId/kiosks/:kioskId", checkInController.RevokeKiosk)
 			checkIns.GET("/:id", checkInController.GetCheckIn)
 			checkIns.PATCH("/:id/tx", checkInController.UpdateTxHash)
 			checkIns.DELETE("/:id", checkInController.DeleteCheckIn)
 		}
 
+		// Attendance sessions
+		attendance := api.Group("/attendance")
+		{
+			attendance.POST("/event/:eventId/sessions", attendanceController.CreateSession)
+			attendance.GET("/event/:eventId/sessions", attendanceController.ListSessions)
+			attendance.GET("/event/:eventId/report", attendanceController.GetAttendanceReport)
+			attendance.GET("/event/:eventId/teams/:teamId/eligibility", attendanceController.GetTeamEligibility)
+			attendance.PUT("/sessions/:sessionId", attendanceController.UpdateSession)
+			attendance.DELETE("/sessions/:sessionId", attendanceController.DeleteSession)
+			attendance.POST("/sessions/:sessionId/check-in", attendanceController.CheckIn)
+			attendance.POST("/sessions/:sessionId/check-out", attendanceController.CheckOut)
+			attendance.GET("/sessions/:sessionId/attendees", attendanceController.ListAttendees)
+		}
+
 		// Submissions
 		submissions := api.Group("/submissions")
 		{
@@ -147,19 +251,76 @@ func main() {
 			submissions.PUT("/:id", submissionController.UpdateSubmission)
 			submissions.PATCH("/:id/approve", submissionController.ApproveSubmission)
 			submissions.PATCH("/:id/reject", submissionController.RejectSubmission)
+			submissions.POST("/:id/withdraw", submissionController.WithdrawSubmission)
+			submissions.GET("/:id/status-history", submissionController.GetStatusHistory)
 			submissions.DELETE("/:id", submissionController.DeleteSubmission)
+			submissions.POST("/:id/finalize", submissionController.FinalizeSubmission)
+			submissions.POST("/:id/signoff", submissionController.SignOffSubmission)
+			submissions.GET("/:id/signoffs", submissionController.GetSignoffStatus)
+			submissions.GET("/:id/revisions", submissionController.ListRevisions)
+			submissions.GET("/:id/revisions/diff", submissionController.DiffRevisions)
+			submissions.GET("/:id/revisions/at-deadline", submissionController.GetRevisionAtDeadline)
+			submissions.GET("/:id/revisions/:revision", submissionController.GetRevision)
+			submissions.GET("/:id/fingerprint", submissionController.GetFingerprint)
+			submissions.POST("/verify", submissionController.VerifySubmission)
+			submissions.GET("/:id/anchor-proof", anchorController.GetSubmissionProof)
+			submissions.GET("/:id/screening", screeningController.GetStatus)
+			submissions.POST("/:id/screening/verdicts", screeningController.SubmitVerdict)
+			submissions.DELETE("/:id/screening/assignments/:assignmentId", screeningController.RemoveAssignment)
+			submissions.GET("/screening/queue", screeningController.ListQueue)
+			submissions.GET("/:id/repo-snapshots", snapshotController.ListSnapshots)
+			submissions.POST("/:id/repo-snapshots", snapshotController.SnapshotSubmission)
+			submissions.GET("/:id/repo-snapshots/:snapshotId/archive", snapshotController.DownloadArchive)
+			submissions.GET("/event/:eventId/anchor", anchorController.GetAnchor)
+			submissions.POST("/event/:eventId/anchor", anchorController.AnchorEvent)
+			submissions.GET("/event/:eventId/extensions", submissionController.ListExtensions)
+			submissions.POST("/event/:eventId/extensions", submissionController.GrantExtension)
+			submissions.DELETE("/event/:eventId/extensions/:extensionId", submissionController.RevokeExtension)
+			submissions.GET("/event/:eventId/requirements", submissionController.GetRequirements)
+			submissions.PUT("/event/:eventId/requirements", submissionController.SetRequirements)
+			submissions.GET("/event/:eventId/similarity", similarityController.GetEventReport)
+			submissions.GET("/event/:eventId/screening-policy", screeningController.GetPolicy)
+			submissions.PUT("/event/:eventId/screening-policy", screeningController.SetPolicy)
+			submissions.POST("/event/:eventId/screening/assign", screeningController.AssignReviewers)
+		}
+
+		// Uploads
+		uploads := api.Group("/uploads")
+		{
+			uploads.POST("/event/:eventId", uploadController.Upload)
+			uploads.GET("/event/:eventId", uploadController.ListByEvent)
+			uploads.GET("/:cid", uploadController.Download)
 		}
 
 		// Votes
 		votes := api.Group("/votes")
 		{
 			votes.POST("", voteController.CastVote)
+			votes.POST("/typed-data", voteController.PrepareVote)
 			votes.GET("/event/:eventId", voteController.ListVotesByEvent)
 			votes.GET("/event/:eventId/summary", voteController.GetEventSummary)
+			votes.GET("/event/:eventId/credits", voteController.GetVoteCredits)
+			votes.GET("/event/:eventId/commitments", voteController.GetCommitStatus)
+			votes.POST("/commit", voteController.CommitVote)
+			votes.POST("/commit/typed-data", voteController.PrepareCommitment)
+			votes.POST("/reveal", voteController.RevealVote)
 			votes.GET("/submission/:submissionId", voteController.ListVotesBySubmission)
+			votes.GET("/submission/:submissionId/scores", voteController.ListScorecards)
+			votes.POST("/scores", voteController.SubmitScorecard)
+			votes.POST("/scores/typed-data", voteController.PrepareScorecard)
 			votes.GET("/:id", voteController.GetVote)
+			votes.GET("/:id/verify", voteController.VerifyVote)
 			votes.DELETE("/:id", voteController.DeleteVote)
 		}
+
+		// Ranked ballots
+		ballots := api.Group("/ballots")
+		{
+			ballots.POST("", ballotController.CastBallot)
+			ballots.POST("/typed-data", ballotController.PrepareBallot)
+			ballots.GET("/event/:eventId", ballotController.GetBallot)
+			ballots.GET("/event/:eventId/results", ballotController.GetResults)
+		}
 	}
 
 	// Start server
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// AttendanceRequirement describes how a session counts towards award eligibility
+type AttendanceRequirement string
+
+const (
+	AttendanceRequirementNone       AttendanceRequirement = "none"        // Informational only
+	AttendanceRequirementAnyMember  AttendanceRequirement = "any_member"  // At least one team member must attend
+	AttendanceRequirementAllMembers AttendanceRequirement = "all_members" // Every team member must attend
+)
+
+// AttendanceSession represents a tracked part of a multi-day event
+// (a day, a meal, a workshop, the final demo, ...)
+type AttendanceSession struct {
+	ID          uint                  `json:"id" gorm:"primaryKey"`
+	EventID     uint                  `json:"event_id" gorm:"not null;index"`
+	Name        string                `json:"name" gorm:"not null"` // e.g., "Day 1", "Dinner", "Final demo"
+	Description string                `json:"description" gorm:"type:text"`
+	Kind        string                `json:"kind" gorm:"type:varchar(50)"` // e.g., "day", "meal", "workshop", "demo"
+	StartTime   time.Time             `json:"start_time" gorm:"not null"`
+	EndTime     time.Time             `json:"end_time" gorm:"not null"`
+	Requirement AttendanceRequirement `json:"requirement" gorm:"type:varchar(20);default:'none'"`
+	CreatedAt   time.Time             `json:"created_at"`
+	UpdatedAt   time.Time             `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt        `json:"deleted_at" gorm:"index"`
+}
+
+// SessionAttendance records a participant's check-in and check-out for a session
+type SessionAttendance struct {
+	ID                uint       `json:"id" gorm:"primaryKey"`
+	SessionID         uint       `json:"session_id" gorm:"not null;index;uniqueIndex:idx_session_attendee"`
+	EventID           uint       `json:"event_id" gorm:"not null;index"`
+	UserAddress       string     `json:"user_address" gorm:"type:varchar(255);not null;uniqueIndex:idx_session_attendee"`
+	TeamID            *uint      `json:"team_id"`
+	CheckInTime       time.Time  `json:"check_in_time" gorm:"not null"`
+	CheckInSignature  string     `json:"check_in_signature"`
+	CheckInMessage    string     `json:"check_in_message" gorm:"type:text"`
+	CheckOutTime      *time.Time `json:"check_out_time"`
+	CheckOutSignature string     `json:"check_out_signature"`
+	CheckOutMessage   string     `json:"check_out_message" gorm:"type:text"`
+	CreatedAt         time.Time  `json:"created_at"`
+	UpdatedAt         time.Time  `json:"updated_at"`
+}
+
+// TableName specifies the table name for AttendanceSession
+func (AttendanceSession) TableName() string {
+	return "attendance_sessions"
+}
+
+// TableName specifies the table name for SessionAttendance
+func (SessionAttendance) TableName() string {
+	return "session_attendances"
+}
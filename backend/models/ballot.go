@@ -0,0 +1,43 @@
+package models
+
+import "time"
+
+// BallotMethod selects how ranked ballots are tallied
+type BallotMethod string
+
+const (
+	BallotBorda   BallotMethod = "borda"   // Points by position, top rank scores the most
+	BallotIRV     BallotMethod = "irv"     // Instant-runoff: eliminate the weakest first preference until a majority
+	BallotSchulze BallotMethod = "schulze" // Condorcet method using the strongest pairwise paths
+)
+
+// Ballot is a voter's ranked list of submissions for an event
+type Ballot struct {
+	ID            uint            `json:"id" gorm:"primaryKey"`
+	EventID       uint            `json:"event_id" gorm:"not null;index;uniqueIndex:idx_ballot_voter"`
+	VoterAddress  string          `json:"voter_address" gorm:"size:100;not null;uniqueIndex:idx_ballot_voter"`
+	Rankings      []BallotRanking `json:"rankings" gorm:"foreignKey:BallotID"`
+	Signature     string          `json:"signature"`
+	SignedPayload string          `json:"signed_payload" gorm:"type:text"` // EIP-712 typed data the signature covers, kept for audits
+	Nonce         uint64          `json:"nonce" gorm:"default:0"`
+	CreatedAt     time.Time       `json:"created_at"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+}
+
+// BallotRanking places one submission on a ballot, 1 being the favourite
+type BallotRanking struct {
+	ID           uint `json:"id" gorm:"primaryKey"`
+	BallotID     uint `json:"ballot_id" gorm:"not null;index"`
+	SubmissionID uint `json:"submission_id" gorm:"not null"`
+	Rank         int  `json:"rank" gorm:"not null"`
+}
+
+// TableName overrides the table name for Ballot.
+func (Ballot) TableName() string {
+	return "ballots"
+}
+
+// TableName overrides the table name for BallotRanking.
+func (BallotRanking) TableName() string {
+	return "ballot_rankings"
+}
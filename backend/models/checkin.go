@@ -18,6 +18,12 @@ type CheckIn struct {
 	CheckInTime     time.Time `json:"check_in_time" gorm:"not null"`
 	IPAddress       string    `json:"ip_address" gorm:"type:varchar(255)"` // IP address for security
 	DeviceInfo      string    `json:"device_info"` // Device information
+	KioskID         *uint     `json:"kiosk_id" gorm:"index"` // Set when collected offline by a staff kiosk
+	Latitude        *float64  `json:"latitude"` // Client-reported location
+	Longitude       *float64  `json:"longitude"`
+	DistanceMeters  *float64  `json:"distance_meters"` // Distance evaluated against the venue geofence
+	OverrideBy      string    `json:"override_by" gorm:"type:varchar(255)"` // Organizer who overrode a failed geofence check
+	OverrideSignature string  `json:"override_signature" gorm:"type:text"` // Organizer signature over the override message
 	CreatedAt       time.Time `json:"created_at"`
 	UpdatedAt       time.Time `json:"updated_at"`
 	DeletedAt       gorm.DeletedAt `json:"deleted_at" gorm:"index"`
@@ -36,8 +42,26 @@ type CheckInQRCode struct {
 	CreatedAt    time.Time `json:"created_at"`
 }
 
+// CheckInKiosk represents a staff device authorized by the organizer to
+// collect participant-signed check-ins offline and sync them later
+type CheckInKiosk struct {
+	ID           uint       `json:"id" gorm:"primaryKey"`
+	EventID      uint       `json:"event_id" gorm:"not null;index;uniqueIndex:idx_kiosk_event_staff"`
+	StaffAddress string     `json:"staff_address" gorm:"type:varchar(255);not null;uniqueIndex:idx_kiosk_event_staff"` // Wallet address of the staff key
+	Label        string     `json:"label"` // e.g., "Front desk tablet"
+	AuthorizedBy string     `json:"authorized_by" gorm:"type:varchar(255);not null"` // Organizer address
+	RevokedAt    *time.Time `json:"revoked_at"`
+	LastSyncAt   *time.Time `json:"last_sync_at"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
 // TableName specifies the table name for CheckIn
 func (CheckIn) TableName() string {
 	return "check_ins"
 }
 
+// TableName specifies the table name for CheckInKiosk
+func (CheckInKiosk) TableName() string {
+	return "check_in_kiosks"
+}
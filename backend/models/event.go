@@ -44,14 +44,35 @@ type Event struct {
 	CheckInEndTime        *time.Time `json:"checkin_end_time"`
 	SubmissionStartTime   *time.Time `json:"submission_start_time"`
 	SubmissionEndTime     *time.Time `json:"submission_end_time"`
+	SubmissionGraceMins   int        `json:"submission_grace_minutes" gorm:"default:0"` // Submissions after the deadline but within grace are accepted and marked late
+	RequireMemberSignoff  bool       `json:"require_member_signoff" gorm:"default:false"` // Every team member must sign off before a submission becomes pending
 	VotingStartTime       *time.Time `json:"voting_start_time"`
 	VotingEndTime         *time.Time `json:"voting_end_time"`
+	CommitReveal          bool       `json:"commit_reveal" gorm:"default:false"` // Voters commit hashed votes during voting and reveal them afterwards
+	RevealEndTime         *time.Time `json:"reveal_end_time"` // End of the reveal window that follows VotingEndTime
 	CurrentStage          EventStage `json:"current_stage" gorm:"type:varchar(50);default:'registration'"`
 	OrganizerAddress      string     `json:"organizer_address" gorm:"type:varchar(255);not null"` // Wallet address of organizer
 	AllowSponsorVoting    bool       `json:"allow_sponsor_voting" gorm:"default:false"`
 	AllowPublicVoting     bool       `json:"allow_public_voting" gorm:"default:false"`
+	PublicVotingMode      PublicVotingMode `json:"public_voting_mode" gorm:"type:varchar(20);default:'standard'"`
+	PublicVoteCredits     int        `json:"public_vote_credits" gorm:"default:0"` // Credit budget per public voter in quadratic mode; 0 uses the default
+	PublicRequireSBT      bool       `json:"public_require_sbt" gorm:"default:false"` // Public voters must be on a team whose registration SBT was minted
+	PublicRequireCheckIn  bool       `json:"public_require_checkin" gorm:"default:false"` // Public voters must have checked in
+	PublicRequireAllowlist bool      `json:"public_require_allowlist" gorm:"default:false"` // Public voters must be on the uploaded allowlist
+	PublicRequirePersonhood bool     `json:"public_require_personhood" gorm:"default:false"` // Public voters must pass the proof-of-personhood verifier
+	BallotMethod          BallotMethod `json:"ballot_method" gorm:"type:varchar(20)"` // Enables ranked ballots tallied with this method; empty disables them
+	BallotMaxRanks        int        `json:"ballot_max_ranks" gorm:"default:0"` // Submissions a ballot may rank; 0 uses the default
+	ResultsFrozen         bool       `json:"results_frozen" gorm:"default:false"` // Hide vote tallies from everyone but the organizer
+	ScoreNormalization    ScoreNormalization `json:"score_normalization" gorm:"type:varchar(20);default:'none'"` // How judges' rubric scores are calibrated in results
 	ContractAddress       string     `json:"contract_address" gorm:"type:varchar(255)"` // On-chain contract address
 	OnChain               bool       `json:"on_chain" gorm:"default:false"` // Whether event is on-chain
+	GeofenceLat           *float64   `json:"geofence_latitude"` // Optional venue geofence center
+	GeofenceLng           *float64   `json:"geofence_longitude"`
+	GeofenceRadius        *float64   `json:"geofence_radius_meters"` // Circle radius in meters
+	GeofencePolygon       string     `json:"geofence_polygon" gorm:"type:text"` // Optional JSON array of [lat, lng] vertices; takes precedence over the circle
+	GeofenceTolerance     float64    `json:"geofence_tolerance_meters" gorm:"default:0"`
+	MaxUploadBytes        int64      `json:"max_upload_bytes" gorm:"default:0"` // Per-file upload limit; 0 uses the server default
+	AllowedUploadTypes    string     `json:"allowed_upload_types"` // Comma-separated MIME types (image/*) or extensions (.pdf); empty allows any
 	Prizes                []Prize    `json:"prizes" gorm:"foreignKey:EventID"`
 	CreatedAt             time.Time  `json:"created_at"`
 	UpdatedAt             time.Time  `json:"updated_at"`
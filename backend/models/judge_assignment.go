@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// JudgeAssignmentStatus represents whether an assignment still counts
+type JudgeAssignmentStatus string
+
+const (
+	JudgeAssignmentActive   JudgeAssignmentStatus = "active"
+	JudgeAssignmentReleased JudgeAssignmentStatus = "released" // The judge dropped out and the submission was reassigned
+)
+
+// JudgeAssignment assigns a whitelisted judge to review a submission
+type JudgeAssignment struct {
+	ID           uint                  `json:"id" gorm:"primaryKey"`
+	EventID      uint                  `json:"event_id" gorm:"not null;index"`
+	SubmissionID uint                  `json:"submission_id" gorm:"not null;index"`
+	JudgeAddress string                `json:"judge_address" gorm:"size:100;not null;index"`
+	Status       JudgeAssignmentStatus `json:"status" gorm:"type:varchar(20);default:'active'"`
+	AssignedBy   string                `json:"assigned_by" gorm:"type:varchar(255)"`
+	ReplacedID   *uint                 `json:"replaced_id"` // Released assignment this one took over
+	ReleasedAt   *time.Time            `json:"released_at"`
+	CreatedAt    time.Time             `json:"created_at"`
+	UpdatedAt    time.Time             `json:"updated_at"`
+}
+
+// TableName overrides the table name for JudgeAssignment.
+func (JudgeAssignment) TableName() string {
+	return "judge_assignments"
+}
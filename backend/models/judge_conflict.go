@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// JudgeConflict is a conflict of interest a judge declared with a team,
+// e.g. a former colleague or an advisor relationship
+type JudgeConflict struct {
+	ID           uint      `json:"id" gorm:"primaryKey"`
+	EventID      uint      `json:"event_id" gorm:"not null;index;uniqueIndex:idx_judge_conflict"`
+	JudgeAddress string    `json:"judge_address" gorm:"size:100;not null;uniqueIndex:idx_judge_conflict"`
+	TeamID       uint      `json:"team_id" gorm:"not null;uniqueIndex:idx_judge_conflict"`
+	Reason       string    `json:"reason" gorm:"type:text"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// TableName overrides the table name for JudgeConflict.
+func (JudgeConflict) TableName() string {
+	return "judge_conflicts"
+}
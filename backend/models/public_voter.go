@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// PublicVoterPolicy names an eligibility check a public voter must pass
+type PublicVoterPolicy string
+
+const (
+	PublicVoterPolicySBT        PublicVoterPolicy = "sbt"        // Member of a team whose registration SBT was minted
+	PublicVoterPolicyCheckIn    PublicVoterPolicy = "checkin"    // Has a check-in record for the event
+	PublicVoterPolicyAllowlist  PublicVoterPolicy = "allowlist"  // Listed on the organizer's uploaded allowlist
+	PublicVoterPolicyPersonhood PublicVoterPolicy = "personhood" // Passes the configured proof-of-personhood verifier
+)
+
+// PublicVoterAllowlistEntry is an address the organizer allowed to vote
+// publicly when the allowlist policy is enabled
+type PublicVoterAllowlistEntry struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	EventID   uint      `json:"event_id" gorm:"not null;index;uniqueIndex:idx_public_voter_allowlist"`
+	Address   string    `json:"address" gorm:"size:100;not null;uniqueIndex:idx_public_voter_allowlist"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// TableName overrides the table name for PublicVoterAllowlistEntry.
+func (PublicVoterAllowlistEntry) TableName() string {
+	return "public_voter_allowlist"
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// RepositorySnapshotStatus represents the outcome of a repository snapshot
+type RepositorySnapshotStatus string
+
+const (
+	RepositorySnapshotCompleted RepositorySnapshotStatus = "completed"
+	RepositorySnapshotFailed    RepositorySnapshotStatus = "failed"
+)
+
+// RepositorySnapshot pins the state of a submission's Git repository at the
+// submission deadline, so later force-pushes cannot change what was judged
+type RepositorySnapshot struct {
+	ID              uint                     `json:"id" gorm:"primaryKey"`
+	SubmissionID    uint                     `json:"submission_id" gorm:"not null;index"`
+	EventID         uint                     `json:"event_id" gorm:"not null;index"`
+	RepoURL         string                   `json:"repo_url" gorm:"type:varchar(500);not null"`
+	Status          RepositorySnapshotStatus `json:"status" gorm:"type:varchar(20);not null"`
+	Branch          string                   `json:"branch" gorm:"type:varchar(255)"`
+	CommitSHA       string                   `json:"commit_sha" gorm:"type:varchar(64)"`
+	TreeHash        string                   `json:"tree_hash" gorm:"type:varchar(64)"`
+	ArchiveCID      string                   `json:"archive_cid" gorm:"column:archive_cid;type:varchar(100)"` // tar.gz of the tree in the blob store
+	ArchiveSHA256   string                   `json:"archive_sha256" gorm:"column:archive_sha256;type:varchar(64)"`
+	ArchiveSize     int64                    `json:"archive_size"`
+	Deadline        *time.Time               `json:"deadline"`     // Team's on-time deadline the commits were checked against
+	CommitCount     int                      `json:"commit_count"` // Commits inspected on the snapshot branch
+	LateCommitCount int                      `json:"late_commit_count"`
+	LateCommits     json.RawMessage          `json:"late_commits" gorm:"type:text"` // Commits authored after the deadline
+	Error           string                   `json:"error" gorm:"type:text"`
+	TriggeredBy     string                   `json:"triggered_by" gorm:"type:varchar(255)"` // Organizer address, empty for the scheduled job
+	CreatedAt       time.Time                `json:"created_at"`
+}
+
+// TableName specifies the table name for RepositorySnapshot
+func (RepositorySnapshot) TableName() string {
+	return "repository_snapshots"
+}
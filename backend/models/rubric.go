@@ -0,0 +1,56 @@
+package models
+
+import "time"
+
+// ScoreNormalization selects how judges' rubric scores are calibrated
+// before they are combined
+type ScoreNormalization string
+
+const (
+	NormalizationNone        ScoreNormalization = "none"
+	NormalizationZScore      ScoreNormalization = "zscore"       // Standardize each judge's scores by their own mean and spread
+	NormalizationRank        ScoreNormalization = "rank"         // Replace each judge's scores by their percentile rank
+	NormalizationTrimmedMean ScoreNormalization = "trimmed_mean" // Drop each submission's highest and lowest judge scores
+)
+
+// RubricCriterion is one weighted criterion of an event's judging rubric,
+// e.g. innovation or technical depth
+type RubricCriterion struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	EventID     uint      `json:"event_id" gorm:"not null;index;uniqueIndex:idx_rubric_event_key"`
+	Key         string    `json:"key" gorm:"size:50;not null;uniqueIndex:idx_rubric_event_key"`
+	Name        string    `json:"name" gorm:"type:varchar(255);not null"`
+	Description string    `json:"description" gorm:"type:text"`
+	Weight      float64   `json:"weight" gorm:"type:numeric(24,6);default:1"`
+	MinScore    int       `json:"min_score" gorm:"default:1"`
+	MaxScore    int       `json:"max_score" gorm:"default:10"`
+	Position    int       `json:"position" gorm:"default:0"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// RubricScore is a judge's score for one criterion of a submission
+type RubricScore struct {
+	ID            uint      `json:"id" gorm:"primaryKey"`
+	EventID       uint      `json:"event_id" gorm:"not null;index"`
+	SubmissionID  uint      `json:"submission_id" gorm:"not null;index;uniqueIndex:idx_rubric_score"`
+	JudgeAddress  string    `json:"judge_address" gorm:"size:100;not null;uniqueIndex:idx_rubric_score"`
+	CriterionID   uint      `json:"criterion_id" gorm:"not null;uniqueIndex:idx_rubric_score"`
+	Score         int       `json:"score" gorm:"not null"`
+	Comment       string    `json:"comment" gorm:"type:text"`
+	Signature     string    `json:"signature"`                       // The judge's signature over the whole scorecard
+	SignedPayload string    `json:"signed_payload" gorm:"type:text"` // EIP-712 typed data the signature covers, kept for audits
+	Nonce         uint64    `json:"nonce" gorm:"default:0"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// TableName overrides the table name for RubricCriterion.
+func (RubricCriterion) TableName() string {
+	return "rubric_criteria"
+}
+
+// TableName overrides the table name for RubricScore.
+func (RubricScore) TableName() string {
+	return "rubric_scores"
+}
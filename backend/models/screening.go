@@ -0,0 +1,79 @@
+package models
+
+import "time"
+
+// ScreeningRule decides a submission once enough verdicts are in
+type ScreeningRule string
+
+const (
+	ScreeningRuleMajority  ScreeningRule = "majority"  // More eligible than ineligible verdicts approves; a tie waits for outstanding reviewers, then goes to the organizer
+	ScreeningRuleUnanimous ScreeningRule = "unanimous" // Any ineligible verdict rejects
+)
+
+// ScreeningVerdictValue is a reviewer's eligibility verdict
+type ScreeningVerdictValue string
+
+const (
+	ScreeningVerdictEligible   ScreeningVerdictValue = "eligible"
+	ScreeningVerdictIneligible ScreeningVerdictValue = "ineligible"
+)
+
+// ScreeningPolicy configures multi-reviewer screening for an event. When
+// enabled, pending submissions are approved or rejected by their assigned
+// reviewers' verdicts instead of a single organizer decision.
+type ScreeningPolicy struct {
+	ID                     uint          `json:"id" gorm:"primaryKey"`
+	EventID                uint          `json:"event_id" gorm:"not null;uniqueIndex"`
+	Enabled                bool          `json:"enabled" gorm:"default:false"`
+	ReviewersPerSubmission int           `json:"reviewers_per_submission" gorm:"default:2"`
+	Quorum                 int           `json:"quorum" gorm:"default:2"` // Verdicts needed before a decision
+	Rule                   ScreeningRule `json:"rule" gorm:"type:varchar(20);default:'majority'"`
+	UpdatedBy              string        `json:"updated_by" gorm:"type:varchar(255)"` // Organizer address
+	CreatedAt              time.Time     `json:"created_at"`
+	UpdatedAt              time.Time     `json:"updated_at"`
+}
+
+// ScreeningAssignment assigns a reviewer to a submission and holds the
+// reviewer's current verdict
+type ScreeningAssignment struct {
+	ID              uint                  `json:"id" gorm:"primaryKey"`
+	SubmissionID    uint                  `json:"submission_id" gorm:"not null;uniqueIndex:idx_screening_reviewer"`
+	EventID         uint                  `json:"event_id" gorm:"not null;index"`
+	ReviewerAddress string                `json:"reviewer_address" gorm:"type:varchar(255);not null;uniqueIndex:idx_screening_reviewer;index"` // Normalized wallet address
+	AssignedBy      string                `json:"assigned_by" gorm:"type:varchar(255)"`
+	Verdict         ScreeningVerdictValue `json:"verdict" gorm:"type:varchar(20)"` // Empty until the reviewer decides
+	Notes           string                `json:"notes" gorm:"type:text"`
+	SubmissionHash  string                `json:"submission_hash" gorm:"type:varchar(100)"` // Content the verdict applies to; later edits make it stale
+	DecidedAt       *time.Time            `json:"decided_at"`
+	CreatedAt       time.Time             `json:"created_at"`
+	UpdatedAt       time.Time             `json:"updated_at"`
+}
+
+// ScreeningVerdict is one entry in a submission's screening audit trail.
+// Entries are append-only, so changed verdicts stay visible.
+type ScreeningVerdict struct {
+	ID              uint                  `json:"id" gorm:"primaryKey"`
+	AssignmentID    uint                  `json:"assignment_id" gorm:"not null;index"`
+	SubmissionID    uint                  `json:"submission_id" gorm:"not null;index"`
+	ReviewerAddress string                `json:"reviewer_address" gorm:"type:varchar(255);not null"`
+	Verdict         ScreeningVerdictValue `json:"verdict" gorm:"type:varchar(20);not null"`
+	Notes           string                `json:"notes" gorm:"type:text"`
+	SubmissionHash  string                `json:"submission_hash" gorm:"type:varchar(100)"`
+	ByOrganizer     bool                  `json:"by_organizer" gorm:"default:false"` // Organizer tie-break or override; AssignmentID is 0
+	CreatedAt       time.Time             `json:"created_at"`
+}
+
+// TableName specifies the table name for ScreeningPolicy
+func (ScreeningPolicy) TableName() string {
+	return "screening_policies"
+}
+
+// TableName specifies the table name for ScreeningAssignment
+func (ScreeningAssignment) TableName() string {
+	return "screening_assignments"
+}
+
+// TableName specifies the table name for ScreeningVerdict
+func (ScreeningVerdict) TableName() string {
+	return "screening_verdicts"
+}
@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+// VotingCurve shapes how the value of a sponsor's deposits turns into
+// voting power
+type VotingCurve string
+
+const (
+	VotingCurveLinear    VotingCurve = "linear"    // Power equals value
+	VotingCurveQuadratic VotingCurve = "quadratic" // Power is the square root of value, so large deposits buy less influence per token
+	VotingCurveCapped    VotingCurve = "capped"    // Power equals value up to the rule's cap
+)
+
+// SponsorVotingRule converts deposits of one asset into voting power for
+// an event, e.g. 1 vote per 100 USDC
+type SponsorVotingRule struct {
+	ID           uint        `json:"id" gorm:"primaryKey"`
+	EventID      uint        `json:"event_id" gorm:"not null;index;uniqueIndex:idx_sponsor_voting_rule"`
+	AssetType    AssetType   `json:"asset_type" gorm:"type:varchar(20);not null;uniqueIndex:idx_sponsor_voting_rule"`
+	TokenAddress string      `json:"token_address" gorm:"size:100;not null;default:'';uniqueIndex:idx_sponsor_voting_rule"` // Empty for the native asset
+	Symbol       string      `json:"symbol" gorm:"type:varchar(20)"`
+	Decimals     uint8       `json:"decimals" gorm:"default:0"`             // Smallest units per whole token are 10^Decimals
+	Rate         string      `json:"rate" gorm:"type:varchar(78);not null"` // Voting power per whole token, as a decimal string
+	Curve        VotingCurve `json:"curve" gorm:"type:varchar(20);default:'linear'"`
+	Cap          float64     `json:"cap" gorm:"type:numeric(24,6);default:0"` // Maximum power from this asset under the capped curve
+	CreatedAt    time.Time   `json:"created_at"`
+	UpdatedAt    time.Time   `json:"updated_at"`
+}
+
+// TableName overrides the table name for SponsorVotingRule.
+func (SponsorVotingRule) TableName() string {
+	return "sponsor_voting_rules"
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"encoding/json"
 	"time"
 
 	"gorm.io/gorm"
@@ -10,9 +11,13 @@ import (
 type SubmissionStatus string
 
 const (
-	SubmissionStatusPending  SubmissionStatus = "pending"
-	SubmissionStatusApproved SubmissionStatus = "approved"
-	SubmissionStatusRejected SubmissionStatus = "rejected"
+	SubmissionStatusDraft            SubmissionStatus = "draft"            // Teammates are still editing
+	SubmissionStatusAwaitingSignoff  SubmissionStatus = "awaiting_signoff" // Submitted, waiting for every member to sign off
+	SubmissionStatusPending          SubmissionStatus = "pending"
+	SubmissionStatusApproved         SubmissionStatus = "approved"
+	SubmissionStatusRejected         SubmissionStatus = "rejected"
+	SubmissionStatusChangesRequested SubmissionStatus = "changes_requested" // Sent back by the organizer; the team may edit and resubmit
+	SubmissionStatusWithdrawn        SubmissionStatus = "withdrawn"         // Withdrawn by the team; no longer counts
 )
 
 // Submission represents a project submission for an event
@@ -25,9 +30,12 @@ type Submission struct {
 	GithubRepo      string           `json:"github_repo"`
 	DemoURL         string           `json:"demo_url"`
 	Documentation   string           `json:"documentation"`
-	SubmissionHash  string           `json:"submission_hash"` // On-chain fingerprint / IPFS hash
-	StorageURL      string           `json:"storage_url"`     // IPFS / Arweave URL
+	SubmissionHash  string           `json:"submission_hash"`                   // On-chain fingerprint / IPFS hash
+	StorageURL      string           `json:"storage_url"`                       // IPFS / Arweave URL
+	Declarations    json.RawMessage  `json:"declarations" gorm:"type:text"`     // JSON object of declaration key -> value, e.g. {"license": "MIT"}
+	CurrentRevision int              `json:"current_revision" gorm:"default:1"` // Latest SubmissionRevision number
 	Status          SubmissionStatus `json:"status" gorm:"type:varchar(20);default:'pending'"`
+	IsLate          bool             `json:"is_late" gorm:"default:false"` // Created or changed during the grace period
 	ReviewerComment string           `json:"reviewer_comment" gorm:"type:text"`
 	SubmittedBy     string           `json:"submitted_by" gorm:"not null"` // Wallet address
 	SubmittedAt     time.Time        `json:"submitted_at" gorm:"not null"`
@@ -47,6 +55,8 @@ type SubmissionFile struct {
 	FileType     string    `json:"file_type"`
 	URL          string    `json:"url"` // IPFS / external URL
 	Hash         string    `json:"hash"`
+	CID          string    `json:"cid" gorm:"column:cid;type:varchar(100);index"` // Set when the file was uploaded to the built-in store
+	Size         int64     `json:"size"`
 	CreatedAt    time.Time `json:"created_at"`
 	UpdatedAt    time.Time `json:"updated_at"`
 }
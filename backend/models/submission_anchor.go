@@ -0,0 +1,62 @@
+package models
+
+import (
+	"time"
+)
+
+// AnchorStatus represents the publication state of an anchored root
+type AnchorStatus string
+
+const (
+	AnchorStatusPending   AnchorStatus = "pending"   // Tree built, not yet published on-chain
+	AnchorStatusSubmitted AnchorStatus = "submitted" // Transaction sent, awaiting receipt
+	AnchorStatusConfirmed AnchorStatus = "confirmed"
+	AnchorStatusFailed    AnchorStatus = "failed"
+	AnchorStatusEmpty     AnchorStatus = "empty"        // Window closed without finalized submissions; nothing to publish
+	AnchorStatusUnbuilt   AnchorStatus = "build_failed" // Tree could not be built, see LastError
+)
+
+// SubmissionAnchor is the Merkle root over all submission fingerprints of an
+// event, published on-chain once the submission window closes
+type SubmissionAnchor struct {
+	ID              uint         `json:"id" gorm:"primaryKey"`
+	EventID         uint         `json:"event_id" gorm:"not null;uniqueIndex"`
+	Root            string       `json:"root" gorm:"type:varchar(66);not null"`
+	TreeSize        int          `json:"tree_size" gorm:"not null"`
+	Cutoff          time.Time    `json:"cutoff" gorm:"not null"` // Submission revisions current at this time are anchored
+	Status          AnchorStatus `json:"status" gorm:"type:varchar(20);default:'pending'"`
+	TxHash          string       `json:"tx_hash" gorm:"type:varchar(66)"`
+	ChainID         string       `json:"chain_id" gorm:"type:varchar(32)"`
+	ContractAddress string       `json:"contract_address" gorm:"type:varchar(255)"` // Empty when anchored as plain calldata
+	BlockNumber     *uint64      `json:"block_number"`
+	Attempts        int          `json:"attempts" gorm:"default:0"`
+	LastError       string       `json:"last_error" gorm:"type:text"`
+	TriggeredBy     string       `json:"triggered_by" gorm:"type:varchar(255)"` // Organizer address, or empty for the scheduled job
+	CreatedAt       time.Time    `json:"created_at"`
+	UpdatedAt       time.Time    `json:"updated_at"`
+}
+
+// SubmissionAnchorProof is a submission's inclusion proof in its event anchor
+type SubmissionAnchorProof struct {
+	ID             uint      `json:"id" gorm:"primaryKey"`
+	AnchorID       uint      `json:"anchor_id" gorm:"not null;index"`
+	EventID        uint      `json:"event_id" gorm:"not null;index"`
+	SubmissionID   uint      `json:"submission_id" gorm:"not null;uniqueIndex"`
+	Revision       int       `json:"revision"` // Submission revision that was anchored
+	SubmissionHash string    `json:"submission_hash" gorm:"type:varchar(66);not null"`
+	LeafIndex      int       `json:"leaf_index" gorm:"not null"`
+	LeafData       string    `json:"leaf_data" gorm:"type:text;not null"` // Canonical JSON that was hashed into the leaf
+	LeafHash       string    `json:"leaf_hash" gorm:"type:varchar(66);not null"`
+	Proof          string    `json:"proof" gorm:"type:text"` // JSON array of hex sibling hashes, leaf to root
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name for SubmissionAnchor
+func (SubmissionAnchor) TableName() string {
+	return "submission_anchors"
+}
+
+// TableName specifies the table name for SubmissionAnchorProof
+func (SubmissionAnchorProof) TableName() string {
+	return "submission_anchor_proofs"
+}
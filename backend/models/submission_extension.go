@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// SubmissionDeadlineExtension grants one team a later submission deadline,
+// e.g. for accessibility needs or incidents. The most recent active
+// extension for a team applies
+type SubmissionDeadlineExtension struct {
+	ID            uint           `json:"id" gorm:"primaryKey"`
+	EventID       uint           `json:"event_id" gorm:"not null;index:idx_extension_event_team"`
+	TeamID        uint           `json:"team_id" gorm:"not null;index:idx_extension_event_team"`
+	ExtendedUntil time.Time      `json:"extended_until" gorm:"not null"`
+	Reason        string         `json:"reason" gorm:"type:text;not null"`
+	GrantedBy     string         `json:"granted_by" gorm:"type:varchar(255);not null"` // Organizer address
+	CreatedAt     time.Time      `json:"created_at"`
+	UpdatedAt     time.Time      `json:"updated_at"`
+	DeletedAt     gorm.DeletedAt `json:"deleted_at" gorm:"index"`
+}
+
+// TableName specifies the table name for SubmissionDeadlineExtension
+func (SubmissionDeadlineExtension) TableName() string {
+	return "submission_deadline_extensions"
+}
@@ -0,0 +1,26 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// SubmissionRequirements configures what a finalized submission must contain
+// for one event. Events without a record accept any submission with a title
+type SubmissionRequirements struct {
+	ID                   uint            `json:"id" gorm:"primaryKey"`
+	EventID              uint            `json:"event_id" gorm:"not null;uniqueIndex"`
+	RequiredFields       string          `json:"required_fields" gorm:"type:text"`        // Comma-separated: description, github_repo, demo_url, documentation, storage_url, files
+	RequiredFileTypes    string          `json:"required_file_types" gorm:"type:text"`    // Comma-separated MIME types (video/*) or extensions (.pdf); each needs a matching file
+	URLPatterns          json.RawMessage `json:"url_patterns" gorm:"type:text"`           // JSON object of URL field -> regular expression matching the whole URL, or only the host when it has no "/"
+	MaxDescriptionLength int             `json:"max_description_length" gorm:"default:0"` // In characters, 0 for no limit
+	Declarations         json.RawMessage `json:"declarations" gorm:"type:text"`           // JSON array of declarations teams must make, e.g. a license
+	UpdatedBy            string          `json:"updated_by" gorm:"type:varchar(255)"`     // Organizer address
+	CreatedAt            time.Time       `json:"created_at"`
+	UpdatedAt            time.Time       `json:"updated_at"`
+}
+
+// TableName specifies the table name for SubmissionRequirements
+func (SubmissionRequirements) TableName() string {
+	return "submission_requirements"
+}
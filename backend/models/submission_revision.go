@@ -0,0 +1,24 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// SubmissionRevision is an immutable snapshot of a submission's content taken
+// whenever it is created or changed
+type SubmissionRevision struct {
+	ID           uint            `json:"id" gorm:"primaryKey"`
+	SubmissionID uint            `json:"submission_id" gorm:"not null;index;uniqueIndex:idx_submission_revision"`
+	EventID      uint            `json:"event_id" gorm:"not null;index"`
+	Revision     int             `json:"revision" gorm:"not null;uniqueIndex:idx_submission_revision"` // 1-based, increments per change
+	Snapshot     json.RawMessage `json:"snapshot" gorm:"type:longtext;not null"`                       // Canonical JSON of the submitted content
+	RevisionHash string          `json:"revision_hash" gorm:"type:varchar(66);not null"`               // Hash of Snapshot
+	Author       string          `json:"author" gorm:"type:varchar(255);not null"`                     // Wallet address that made the change
+	CreatedAt    time.Time       `json:"created_at" gorm:"index"`
+}
+
+// TableName specifies the table name for SubmissionRevision
+func (SubmissionRevision) TableName() string {
+	return "submission_revisions"
+}
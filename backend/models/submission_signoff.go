@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// SubmissionSignoff records a team member approving a submission's content.
+// A signoff is bound to the submission hash at the time it was given, so any
+// later edit invalidates it.
+type SubmissionSignoff struct {
+	ID             uint      `json:"id" gorm:"primaryKey"`
+	SubmissionID   uint      `json:"submission_id" gorm:"not null;uniqueIndex:idx_signoff_member_hash"`
+	MemberAddress  string    `json:"member_address" gorm:"type:varchar(255);not null;uniqueIndex:idx_signoff_member_hash"` // Normalized wallet address
+	SubmissionHash string    `json:"submission_hash" gorm:"type:varchar(100);not null;uniqueIndex:idx_signoff_member_hash"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name for SubmissionSignoff
+func (SubmissionSignoff) TableName() string {
+	return "submission_signoffs"
+}
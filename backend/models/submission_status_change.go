@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// SubmissionStatusChange is one entry in a submission's status history.
+// Entries are append-only.
+type SubmissionStatusChange struct {
+	ID           uint             `json:"id" gorm:"primaryKey"`
+	SubmissionID uint             `json:"submission_id" gorm:"not null;index"`
+	FromStatus   SubmissionStatus `json:"from_status" gorm:"type:varchar(20)"` // Empty when the submission was created
+	ToStatus     SubmissionStatus `json:"to_status" gorm:"type:varchar(20);not null"`
+	ChangedBy    string           `json:"changed_by" gorm:"type:varchar(255);not null"` // Wallet address of the member or organizer
+	Comment      string           `json:"comment" gorm:"type:text"`
+	CreatedAt    time.Time        `json:"created_at"`
+}
+
+// TableName specifies the table name for SubmissionStatusChange
+func (SubmissionStatusChange) TableName() string {
+	return "submission_status_changes"
+}
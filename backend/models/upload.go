@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+)
+
+// UploadedFile records a blob uploaded for an event. Content lives in the blob
+// store under its CID; the digest and size are computed by the server.
+type UploadedFile struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	EventID     uint      `json:"event_id" gorm:"not null;index;uniqueIndex:idx_upload_event_cid"`
+	CID         string    `json:"cid" gorm:"column:cid;type:varchar(100);not null;index;uniqueIndex:idx_upload_event_cid"` // CIDv1, sha2-256, base32: raw for files up to one 256 KiB chunk, dag-pb UnixFS root for larger files
+	SHA256      string    `json:"sha256" gorm:"column:sha256;type:varchar(64);not null"`                                   // Hex-encoded content digest
+	Size        int64     `json:"size" gorm:"not null"`
+	ContentType string    `json:"content_type" gorm:"type:varchar(255)"` // Detected from content, not the client header
+	FileName    string    `json:"file_name"`
+	UploadedBy  string    `json:"uploaded_by" gorm:"type:varchar(255);not null;index"` // Wallet address
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name for UploadedFile
+func (UploadedFile) TableName() string {
+	return "uploaded_files"
+}
@@ -13,6 +13,14 @@ const (
 	VoterTypePublic  VoterType = "public"
 )
 
+// PublicVotingMode controls how public voters spread their votes.
+type PublicVotingMode string
+
+const (
+	PublicVotingStandard  PublicVotingMode = "standard"  // A fixed number of one-weight votes per voter
+	PublicVotingQuadratic PublicVotingMode = "quadratic" // Voters spend a credit budget; n votes on a submission cost n² credits
+)
+
 // Vote represents a single vote that was cast for a submission.
 type Vote struct {
 	ID            uint      `json:"id" gorm:"primaryKey"`
@@ -21,8 +29,11 @@ type Vote struct {
 	VoterAddress  string    `json:"voter_address" gorm:"size:100;not null;index;uniqueIndex:idx_vote_submission_voter"`
 	VoterType     VoterType `json:"voter_type" gorm:"type:varchar(20);not null;uniqueIndex:idx_vote_submission_voter"`
 	Weight        float64   `json:"weight" gorm:"type:numeric(24,6);default:1"`
+	Credits       int       `json:"credits" gorm:"default:0"` // Credits spent by a quadratic public vote
 	Reason        string    `json:"reason" gorm:"type:text"`
 	Signature     string    `json:"signature"`
+	SignedPayload string    `json:"signed_payload" gorm:"type:text"` // EIP-712 typed data the signature covers, kept for audits
+	Nonce         uint64    `json:"nonce" gorm:"default:0"`
 	OffchainProof string    `json:"offchain_proof"`
 	CreatedAt     time.Time `json:"created_at"`
 	UpdatedAt     time.Time `json:"updated_at"`
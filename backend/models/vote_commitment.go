@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// VoteCommitment is the hash a voter submits during commit-reveal voting.
+// The vote itself is only recorded once the voter reveals the choice and
+// salt that produce the hash
+type VoteCommitment struct {
+	ID           uint       `json:"id" gorm:"primaryKey"`
+	EventID      uint       `json:"event_id" gorm:"not null;index;uniqueIndex:idx_vote_commitment"`
+	VoterAddress string     `json:"voter_address" gorm:"size:100;not null;index;uniqueIndex:idx_vote_commitment"`
+	VoterType    VoterType  `json:"voter_type" gorm:"type:varchar(20);not null"`
+	Commitment   string     `json:"commitment" gorm:"size:66;not null;uniqueIndex:idx_vote_commitment"` // 0x-prefixed keccak256 hash
+	Signature    string     `json:"signature"`                                                          // EIP-712 signature over the commitment typed data
+	Nonce        uint64     `json:"nonce" gorm:"default:0"`
+	Revealed     bool       `json:"revealed" gorm:"default:false"`
+	VoteID       *uint      `json:"vote_id"`
+	RevealedAt   *time.Time `json:"revealed_at"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// TableName overrides the table name for VoteCommitment.
+func (VoteCommitment) TableName() string {
+	return "vote_commitments"
+}
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// VoteCreditAccount is a quadratic voter's credit account for an event.
+// Spending locks the row, so concurrent votes by the same voter are checked
+// against the budget one at a time; the credits themselves are summed from
+// the voter's votes.
+type VoteCreditAccount struct {
+	ID           uint      `json:"id" gorm:"primaryKey"`
+	EventID      uint      `json:"event_id" gorm:"not null;uniqueIndex:idx_vote_credit_account"`
+	VoterAddress string    `json:"voter_address" gorm:"size:100;not null;uniqueIndex:idx_vote_credit_account"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// TableName overrides the table name for VoteCreditAccount.
+func (VoteCreditAccount) TableName() string {
+	return "vote_credit_accounts"
+}
@@ -0,0 +1,19 @@
+package models
+
+import "time"
+
+// VoteNonce tracks the next nonce a voter must sign for an event. Each
+// signed vote consumes one, so a signature cannot be replayed
+type VoteNonce struct {
+	ID           uint      `json:"id" gorm:"primaryKey"`
+	EventID      uint      `json:"event_id" gorm:"not null;uniqueIndex:idx_vote_nonce"`
+	VoterAddress string    `json:"voter_address" gorm:"size:100;not null;uniqueIndex:idx_vote_nonce"`
+	NextNonce    uint64    `json:"next_nonce" gorm:"not null;default:0"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// TableName overrides the table name for VoteNonce.
+func (VoteNonce) TableName() string {
+	return "vote_nonces"
+}
@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"hackathon-platform/backend/models"
+
+	"gorm.io/gorm"
+)
+
+// AttendanceSessionRepository manages attendance sessions of an event.
+type AttendanceSessionRepository interface {
+	Create(session *models.AttendanceSession) error
+	GetByID(id uint) (*models.AttendanceSession, error)
+	ListByEvent(eventID uint) ([]models.AttendanceSession, error)
+	Update(session *models.AttendanceSession) error
+	Delete(id uint) error
+}
+
+type attendanceSessionRepository struct {
+	db *gorm.DB
+}
+
+func NewAttendanceSessionRepository(db *gorm.DB) AttendanceSessionRepository {
+	return &attendanceSessionRepository{db: db}
+}
+
+func (r *attendanceSessionRepository) Create(session *models.AttendanceSession) error {
+	return r.db.Create(session).Error
+}
+
+func (r *attendanceSessionRepository) GetByID(id uint) (*models.AttendanceSession, error) {
+	var session models.AttendanceSession
+	err := r.db.First(&session, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+func (r *attendanceSessionRepository) ListByEvent(eventID uint) ([]models.AttendanceSession, error) {
+	var sessions []models.AttendanceSession
+	err := r.db.Where("event_id = ?", eventID).
+		Order("start_time ASC").
+		Find(&sessions).Error
+	return sessions, err
+}
+
+func (r *attendanceSessionRepository) Update(session *models.AttendanceSession) error {
+	return r.db.Save(session).Error
+}
+
+func (r *attendanceSessionRepository) Delete(id uint) error {
+	return r.db.Delete(&models.AttendanceSession{}, id).Error
+}
@@ -0,0 +1,71 @@
+package repositories
+
+import (
+	"hackathon-platform/backend/models"
+
+	"gorm.io/gorm"
+)
+
+// BallotRepository handles persistence for ranked ballots.
+type BallotRepository interface {
+	GetByEventAndVoter(eventID uint, voterAddress string) (*models.Ballot, error)
+	ListByEvent(eventID uint) ([]models.Ballot, error)
+	Save(ballot *models.Ballot) error
+}
+
+type ballotRepository struct {
+	db *gorm.DB
+}
+
+func NewBallotRepository(db *gorm.DB) BallotRepository {
+	return &ballotRepository{db: db}
+}
+
+func (r *ballotRepository) GetByEventAndVoter(eventID uint, voterAddress string) (*models.Ballot, error) {
+	var ballot models.Ballot
+	err := r.db.Preload("Rankings", func(db *gorm.DB) *gorm.DB {
+		return db.Order("`rank` ASC")
+	}).
+		Where("event_id = ? AND voter_address = ?", eventID, voterAddress).
+		First(&ballot).Error
+	if err != nil {
+		return nil, err
+	}
+	return &ballot, nil
+}
+
+func (r *ballotRepository) ListByEvent(eventID uint) ([]models.Ballot, error) {
+	var ballots []models.Ballot
+	err := r.db.Preload("Rankings", func(db *gorm.DB) *gorm.DB {
+		return db.Order("`rank` ASC")
+	}).
+		Where("event_id = ?", eventID).
+		Order("created_at ASC").
+		Find(&ballots).Error
+	return ballots, err
+}
+
+// Save creates or updates the ballot and replaces its rankings.
+func (r *ballotRepository) Save(ballot *models.Ballot) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		rankings := ballot.Rankings
+		ballot.Rankings = nil
+		if err := tx.Save(ballot).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("ballot_id = ?", ballot.ID).Delete(&models.BallotRanking{}).Error; err != nil {
+			return err
+		}
+		for i := range rankings {
+			rankings[i].ID = 0
+			rankings[i].BallotID = ballot.ID
+		}
+		if len(rankings) > 0 {
+			if err := tx.Create(&rankings).Error; err != nil {
+				return err
+			}
+		}
+		ballot.Rankings = rankings
+		return nil
+	})
+}
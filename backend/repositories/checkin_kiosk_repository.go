@@ -0,0 +1,59 @@
+package repositories
+
+import (
+	"hackathon-platform/backend/models"
+
+	"gorm.io/gorm"
+)
+
+// CheckInKioskRepository manages staff kiosk authorizations.
+type CheckInKioskRepository interface {
+	Create(kiosk *models.CheckInKiosk) error
+	GetByID(id uint) (*models.CheckInKiosk, error)
+	GetByEventAndStaff(eventID uint, staffAddress string) (*models.CheckInKiosk, error)
+	ListByEvent(eventID uint) ([]models.CheckInKiosk, error)
+	Update(kiosk *models.CheckInKiosk) error
+}
+
+type checkInKioskRepository struct {
+	db *gorm.DB
+}
+
+func NewCheckInKioskRepository(db *gorm.DB) CheckInKioskRepository {
+	return &checkInKioskRepository{db: db}
+}
+
+func (r *checkInKioskRepository) Create(kiosk *models.CheckInKiosk) error {
+	return r.db.Create(kiosk).Error
+}
+
+func (r *checkInKioskRepository) GetByID(id uint) (*models.CheckInKiosk, error) {
+	var kiosk models.CheckInKiosk
+	err := r.db.First(&kiosk, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &kiosk, nil
+}
+
+func (r *checkInKioskRepository) GetByEventAndStaff(eventID uint, staffAddress string) (*models.CheckInKiosk, error) {
+	var kiosk models.CheckInKiosk
+	err := r.db.Where("event_id = ? AND staff_address = ?", eventID, staffAddress).
+		First(&kiosk).Error
+	if err != nil {
+		return nil, err
+	}
+	return &kiosk, nil
+}
+
+func (r *checkInKioskRepository) ListByEvent(eventID uint) ([]models.CheckInKiosk, error) {
+	var kiosks []models.CheckInKiosk
+	err := r.db.Where("event_id = ?", eventID).
+		Order("created_at ASC").
+		Find(&kiosks).Error
+	return kiosks, err
+}
+
+func (r *checkInKioskRepository) Update(kiosk *models.CheckInKiosk) error {
+	return r.db.Save(kiosk).Error
+}
@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"hackathon-platform/backend/models"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -13,6 +14,7 @@ type EventRepository interface {
 	Update(event *models.Event) error
 	Delete(id uint) error
 	GetByOrganizer(organizerAddress string) ([]models.Event, error)
+	ListSubmissionClosedBefore(t time.Time) ([]models.Event, error)
 }
 
 type eventRepository struct {
@@ -59,3 +61,9 @@ func (r *eventRepository) GetByOrganizer(organizerAddress string) ([]models.Even
 	return events, err
 }
 
+// ListSubmissionClosedBefore returns events whose submission window ended at or before t.
+func (r *eventRepository) ListSubmissionClosedBefore(t time.Time) ([]models.Event, error) {
+	var events []models.Event
+	err := r.db.Where("submission_end_time IS NOT NULL AND submission_end_time <= ?", t).Find(&events).Error
+	return events, err
+}
@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"hackathon-platform/backend/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// JudgeAssignmentRepository handles persistence for judge assignments.
+type JudgeAssignmentRepository interface {
+	CreateBatch(assignments []models.JudgeAssignment) error
+	ListByEvent(eventID uint) ([]models.JudgeAssignment, error)
+	ListActiveByEvent(eventID uint) ([]models.JudgeAssignment, error)
+	ListActiveByJudge(eventID uint, judgeAddress string) ([]models.JudgeAssignment, error)
+	HasActive(eventID uint) (bool, error)
+	IsAssigned(submissionID uint, judgeAddress string) (bool, error)
+	Release(ids []uint, at time.Time) error
+}
+
+type judgeAssignmentRepository struct {
+	db *gorm.DB
+}
+
+func NewJudgeAssignmentRepository(db *gorm.DB) JudgeAssignmentRepository {
+	return &judgeAssignmentRepository{db: db}
+}
+
+func (r *judgeAssignmentRepository) CreateBatch(assignments []models.JudgeAssignment) error {
+	if len(assignments) == 0 {
+		return nil
+	}
+	return r.db.Create(&assignments).Error
+}
+
+func (r *judgeAssignmentRepository) ListByEvent(eventID uint) ([]models.JudgeAssignment, error) {
+	var assignments []models.JudgeAssignment
+	err := r.db.Where("event_id = ?", eventID).Order("submission_id ASC, created_at ASC").Find(&assignments).Error
+	return assignments, err
+}
+
+func (r *judgeAssignmentRepository) ListActiveByEvent(eventID uint) ([]models.JudgeAssignment, error) {
+	var assignments []models.JudgeAssignment
+	err := r.db.Where("event_id = ? AND status = ?", eventID, models.JudgeAssignmentActive).
+		Order("submission_id ASC, created_at ASC").
+		Find(&assignments).Error
+	return assignments, err
+}
+
+func (r *judgeAssignmentRepository) ListActiveByJudge(eventID uint, judgeAddress string) ([]models.JudgeAssignment, error) {
+	var assignments []models.JudgeAssignment
+	err := r.db.Where("event_id = ? AND judge_address = ? AND status = ?", eventID, judgeAddress, models.JudgeAssignmentActive).
+		Order("created_at ASC").
+		Find(&assignments).Error
+	return assignments, err
+}
+
+func (r *judgeAssignmentRepository) HasActive(eventID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.JudgeAssignment{}).
+		Where("event_id = ? AND status = ?", eventID, models.JudgeAssignmentActive).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *judgeAssignmentRepository) IsAssigned(submissionID uint, judgeAddress string) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.JudgeAssignment{}).
+		Where("submission_id = ? AND judge_address = ? AND status = ?", submissionID, judgeAddress, models.JudgeAssignmentActive).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *judgeAssignmentRepository) Release(ids []uint, at time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.db.Model(&models.JudgeAssignment{}).
+		Where("id IN ?", ids).
+		Updates(map[string]interface{}{"status": models.JudgeAssignmentReleased, "released_at": at}).Error
+}
@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"hackathon-platform/backend/models"
+
+	"gorm.io/gorm"
+)
+
+// JudgeConflictRepository handles persistence for declared judge conflicts.
+type JudgeConflictRepository interface {
+	Create(conflict *models.JudgeConflict) error
+	GetByID(id uint) (*models.JudgeConflict, error)
+	ListByEvent(eventID uint) ([]models.JudgeConflict, error)
+	ListByJudge(eventID uint, judgeAddress string) ([]models.JudgeConflict, error)
+	Exists(eventID uint, judgeAddress string, teamID uint) (bool, error)
+	Delete(id uint) error
+}
+
+type judgeConflictRepository struct {
+	db *gorm.DB
+}
+
+func NewJudgeConflictRepository(db *gorm.DB) JudgeConflictRepository {
+	return &judgeConflictRepository{db: db}
+}
+
+func (r *judgeConflictRepository) Create(conflict *models.JudgeConflict) error {
+	return r.db.Create(conflict).Error
+}
+
+func (r *judgeConflictRepository) GetByID(id uint) (*models.JudgeConflict, error) {
+	var conflict models.JudgeConflict
+	if err := r.db.First(&conflict, id).Error; err != nil {
+		return nil, err
+	}
+	return &conflict, nil
+}
+
+func (r *judgeConflictRepository) ListByEvent(eventID uint) ([]models.JudgeConflict, error) {
+	var conflicts []models.JudgeConflict
+	err := r.db.Where("event_id = ?", eventID).Order("created_at ASC").Find(&conflicts).Error
+	return conflicts, err
+}
+
+func (r *judgeConflictRepository) ListByJudge(eventID uint, judgeAddress string) ([]models.JudgeConflict, error) {
+	var conflicts []models.JudgeConflict
+	err := r.db.Where("event_id = ? AND judge_address = ?", eventID, judgeAddress).
+		Order("created_at ASC").
+		Find(&conflicts).Error
+	return conflicts, err
+}
+
+func (r *judgeConflictRepository) Exists(eventID uint, judgeAddress string, teamID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.JudgeConflict{}).
+		Where("event_id = ? AND judge_address = ? AND team_id = ?", eventID, judgeAddress, teamID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *judgeConflictRepository) Delete(id uint) error {
+	return r.db.Delete(&models.JudgeConflict{}, id).Error
+}
@@ -0,0 +1,64 @@
+package repositories
+
+import (
+	"hackathon-platform/backend/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PublicVoterAllowlistRepository handles persistence for public voter allowlists.
+type PublicVoterAllowlistRepository interface {
+	ListByEvent(eventID uint) ([]models.PublicVoterAllowlistEntry, error)
+	Exists(eventID uint, address string) (bool, error)
+	Add(eventID uint, addresses []string) error
+	Replace(eventID uint, addresses []string) error
+}
+
+type publicVoterAllowlistRepository struct {
+	db *gorm.DB
+}
+
+func NewPublicVoterAllowlistRepository(db *gorm.DB) PublicVoterAllowlistRepository {
+	return &publicVoterAllowlistRepository{db: db}
+}
+
+func (r *publicVoterAllowlistRepository) ListByEvent(eventID uint) ([]models.PublicVoterAllowlistEntry, error) {
+	var entries []models.PublicVoterAllowlistEntry
+	err := r.db.Where("event_id = ?", eventID).Order("address ASC").Find(&entries).Error
+	return entries, err
+}
+
+func (r *publicVoterAllowlistRepository) Exists(eventID uint, address string) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.PublicVoterAllowlistEntry{}).
+		Where("event_id = ? AND address = ?", eventID, address).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// Add inserts addresses that are not on the allowlist yet.
+func (r *publicVoterAllowlistRepository) Add(eventID uint, addresses []string) error {
+	return insertAllowlist(r.db, eventID, addresses)
+}
+
+// Replace swaps the event's allowlist for the given addresses.
+func (r *publicVoterAllowlistRepository) Replace(eventID uint, addresses []string) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("event_id = ?", eventID).Delete(&models.PublicVoterAllowlistEntry{}).Error; err != nil {
+			return err
+		}
+		return insertAllowlist(tx, eventID, addresses)
+	})
+}
+
+func insertAllowlist(db *gorm.DB, eventID uint, addresses []string) error {
+	if len(addresses) == 0 {
+		return nil
+	}
+	entries := make([]models.PublicVoterAllowlistEntry, 0, len(addresses))
+	for _, address := range addresses {
+		entries = append(entries, models.PublicVoterAllowlistEntry{EventID: eventID, Address: address})
+	}
+	return db.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(entries, 500).Error
+}
@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"hackathon-platform/backend/models"
+
+	"gorm.io/gorm"
+)
+
+type RepositorySnapshotRepository interface {
+	Create(snapshot *models.RepositorySnapshot) error
+	GetByID(id uint) (*models.RepositorySnapshot, error)
+	ListBySubmission(submissionID uint) ([]models.RepositorySnapshot, error)
+	HasCompleted(submissionID uint) (bool, error)
+	CountFailed(submissionID uint) (int64, error)
+}
+
+type repositorySnapshotRepository struct {
+	db *gorm.DB
+}
+
+func NewRepositorySnapshotRepository(db *gorm.DB) RepositorySnapshotRepository {
+	return &repositorySnapshotRepository{db: db}
+}
+
+func (r *repositorySnapshotRepository) Create(snapshot *models.RepositorySnapshot) error {
+	return r.db.Create(snapshot).Error
+}
+
+func (r *repositorySnapshotRepository) GetByID(id uint) (*models.RepositorySnapshot, error) {
+	var snapshot models.RepositorySnapshot
+	if err := r.db.First(&snapshot, id).Error; err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// ListBySubmission returns snapshots newest first.
+func (r *repositorySnapshotRepository) ListBySubmission(submissionID uint) ([]models.RepositorySnapshot, error) {
+	var snapshots []models.RepositorySnapshot
+	err := r.db.Where("submission_id = ?", submissionID).Order("id DESC").Find(&snapshots).Error
+	return snapshots, err
+}
+
+func (r *repositorySnapshotRepository) HasCompleted(submissionID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.RepositorySnapshot{}).
+		Where("submission_id = ? AND status = ?", submissionID, models.RepositorySnapshotCompleted).
+		Count(&count).Error
+	return count > 0, err
+}
+
+func (r *repositorySnapshotRepository) CountFailed(submissionID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.RepositorySnapshot{}).
+		Where("submission_id = ? AND status = ?", submissionID, models.RepositorySnapshotFailed).
+		Count(&count).Error
+	return count, err
+}
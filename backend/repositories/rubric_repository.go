@@ -0,0 +1,76 @@
+package repositories
+
+import (
+	"hackathon-platform/backend/models"
+
+	"gorm.io/gorm"
+)
+
+// RubricRepository handles persistence for rubric criteria and judge scores.
+type RubricRepository interface {
+	ListCriteria(eventID uint) ([]models.RubricCriterion, error)
+	ReplaceCriteria(eventID uint, criteria []models.RubricCriterion) error
+	CountScoresByEvent(eventID uint) (int64, error)
+	ReplaceScorecard(submissionID uint, judgeAddress string, scores []models.RubricScore) error
+	ListScoresBySubmission(submissionID uint) ([]models.RubricScore, error)
+	ListScoresByEvent(eventID uint) ([]models.RubricScore, error)
+}
+
+type rubricRepository struct {
+	db *gorm.DB
+}
+
+func NewRubricRepository(db *gorm.DB) RubricRepository {
+	return &rubricRepository{db: db}
+}
+
+func (r *rubricRepository) ListCriteria(eventID uint) ([]models.RubricCriterion, error) {
+	var criteria []models.RubricCriterion
+	err := r.db.Where("event_id = ?", eventID).Order("position ASC, id ASC").Find(&criteria).Error
+	return criteria, err
+}
+
+// ReplaceCriteria swaps the event's rubric for criteria in one transaction.
+func (r *rubricRepository) ReplaceCriteria(eventID uint, criteria []models.RubricCriterion) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("event_id = ?", eventID).Delete(&models.RubricCriterion{}).Error; err != nil {
+			return err
+		}
+		if len(criteria) == 0 {
+			return nil
+		}
+		return tx.Create(&criteria).Error
+	})
+}
+
+func (r *rubricRepository) CountScoresByEvent(eventID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.RubricScore{}).Where("event_id = ?", eventID).Count(&count).Error
+	return count, err
+}
+
+// ReplaceScorecard replaces a judge's scores for a submission.
+func (r *rubricRepository) ReplaceScorecard(submissionID uint, judgeAddress string, scores []models.RubricScore) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("submission_id = ? AND judge_address = ?", submissionID, judgeAddress).
+			Delete(&models.RubricScore{}).Error; err != nil {
+			return err
+		}
+		if len(scores) == 0 {
+			return nil
+		}
+		return tx.Create(&scores).Error
+	})
+}
+
+func (r *rubricRepository) ListScoresBySubmission(submissionID uint) ([]models.RubricScore, error) {
+	var scores []models.RubricScore
+	err := r.db.Where("submission_id = ?", submissionID).Order("judge_address ASC, criterion_id ASC").Find(&scores).Error
+	return scores, err
+}
+
+func (r *rubricRepository) ListScoresByEvent(eventID uint) ([]models.RubricScore, error) {
+	var scores []models.RubricScore
+	err := r.db.Where("event_id = ?", eventID).Order("submission_id ASC, judge_address ASC, criterion_id ASC").Find(&scores).Error
+	return scores, err
+}
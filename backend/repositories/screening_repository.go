@@ -0,0 +1,117 @@
+package repositories
+
+import (
+	"hackathon-platform/backend/models"
+
+	"gorm.io/gorm"
+)
+
+// ScreeningRepository stores screening policies, reviewer assignments and
+// the verdict audit trail.
+type ScreeningRepository interface {
+	GetPolicy(eventID uint) (*models.ScreeningPolicy, error)
+	SavePolicy(policy *models.ScreeningPolicy) error
+	CreateAssignments(assignments []models.ScreeningAssignment) error
+	GetAssignment(id uint) (*models.ScreeningAssignment, error)
+	GetAssignmentByReviewer(submissionID uint, reviewerAddress string) (*models.ScreeningAssignment, error)
+	ListAssignmentsBySubmission(submissionID uint) ([]models.ScreeningAssignment, error)
+	ListAssignmentsByEvent(eventID uint) ([]models.ScreeningAssignment, error)
+	ListOpenAssignmentsByReviewer(reviewerAddress string, eventID uint) ([]models.ScreeningAssignment, error)
+	DeleteAssignment(id uint) error
+	RecordVerdict(assignment *models.ScreeningAssignment, verdict *models.ScreeningVerdict) error
+	ListVerdictsBySubmission(submissionID uint) ([]models.ScreeningVerdict, error)
+}
+
+type screeningRepository struct {
+	db *gorm.DB
+}
+
+func NewScreeningRepository(db *gorm.DB) ScreeningRepository {
+	return &screeningRepository{db: db}
+}
+
+func (r *screeningRepository) GetPolicy(eventID uint) (*models.ScreeningPolicy, error) {
+	var policy models.ScreeningPolicy
+	if err := r.db.Where("event_id = ?", eventID).First(&policy).Error; err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// SavePolicy creates the event's policy or replaces the existing one.
+func (r *screeningRepository) SavePolicy(policy *models.ScreeningPolicy) error {
+	return r.db.Save(policy).Error
+}
+
+func (r *screeningRepository) CreateAssignments(assignments []models.ScreeningAssignment) error {
+	if len(assignments) == 0 {
+		return nil
+	}
+	return r.db.Create(&assignments).Error
+}
+
+func (r *screeningRepository) GetAssignment(id uint) (*models.ScreeningAssignment, error) {
+	var assignment models.ScreeningAssignment
+	if err := r.db.First(&assignment, id).Error; err != nil {
+		return nil, err
+	}
+	return &assignment, nil
+}
+
+func (r *screeningRepository) GetAssignmentByReviewer(submissionID uint, reviewerAddress string) (*models.ScreeningAssignment, error) {
+	var assignment models.ScreeningAssignment
+	err := r.db.Where("submission_id = ? AND reviewer_address = ?", submissionID, reviewerAddress).First(&assignment).Error
+	if err != nil {
+		return nil, err
+	}
+	return &assignment, nil
+}
+
+func (r *screeningRepository) ListAssignmentsBySubmission(submissionID uint) ([]models.ScreeningAssignment, error) {
+	var assignments []models.ScreeningAssignment
+	err := r.db.Where("submission_id = ?", submissionID).Order("created_at ASC").Find(&assignments).Error
+	return assignments, err
+}
+
+func (r *screeningRepository) ListAssignmentsByEvent(eventID uint) ([]models.ScreeningAssignment, error) {
+	var assignments []models.ScreeningAssignment
+	err := r.db.Where("event_id = ?", eventID).Order("created_at ASC").Find(&assignments).Error
+	return assignments, err
+}
+
+// ListOpenAssignmentsByReviewer returns the reviewer's assignments on
+// submissions still pending review, optionally limited to one event.
+func (r *screeningRepository) ListOpenAssignmentsByReviewer(reviewerAddress string, eventID uint) ([]models.ScreeningAssignment, error) {
+	var assignments []models.ScreeningAssignment
+	query := r.db.Joins("JOIN submissions ON submissions.id = screening_assignments.submission_id").
+		Where("screening_assignments.reviewer_address = ? AND submissions.status = ?", reviewerAddress, models.SubmissionStatusPending)
+	if eventID != 0 {
+		query = query.Where("screening_assignments.event_id = ?", eventID)
+	}
+	err := query.Order("screening_assignments.created_at ASC").Find(&assignments).Error
+	return assignments, err
+}
+
+func (r *screeningRepository) DeleteAssignment(id uint) error {
+	return r.db.Delete(&models.ScreeningAssignment{}, id).Error
+}
+
+// RecordVerdict saves the assignment's current verdict and appends it to the
+// audit trail. assignment is nil for organizer decisions.
+func (r *screeningRepository) RecordVerdict(assignment *models.ScreeningAssignment, verdict *models.ScreeningVerdict) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if assignment == nil {
+			return tx.Create(verdict).Error
+		}
+		if err := tx.Save(assignment).Error; err != nil {
+			return err
+		}
+		return tx.Create(verdict).Error
+	})
+}
+
+func (r *screeningRepository) ListVerdictsBySubmission(submissionID uint) ([]models.ScreeningVerdict, error) {
+	var verdicts []models.ScreeningVerdict
+	err := r.db.Where("submission_id = ?", submissionID).Order("created_at ASC, id ASC").Find(&verdicts).Error
+	return verdicts, err
+}
@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"hackathon-platform/backend/models"
+
+	"gorm.io/gorm"
+)
+
+// SessionAttendanceRepository manages per-session check-in/check-out records.
+type SessionAttendanceRepository interface {
+	Create(attendance *models.SessionAttendance) error
+	GetBySessionAndUser(sessionID uint, userAddress string) (*models.SessionAttendance, error)
+	ListBySession(sessionID uint) ([]models.SessionAttendance, error)
+	ListByEvent(eventID uint) ([]models.SessionAttendance, error)
+	Update(attendance *models.SessionAttendance) error
+}
+
+type sessionAttendanceRepository struct {
+	db *gorm.DB
+}
+
+func NewSessionAttendanceRepository(db *gorm.DB) SessionAttendanceRepository {
+	return &sessionAttendanceRepository{db: db}
+}
+
+func (r *sessionAttendanceRepository) Create(attendance *models.SessionAttendance) error {
+	return r.db.Create(attendance).Error
+}
+
+func (r *sessionAttendanceRepository) GetBySessionAndUser(sessionID uint, userAddress string) (*models.SessionAttendance, error) {
+	var attendance models.SessionAttendance
+	err := r.db.Where("session_id = ? AND user_address = ?", sessionID, userAddress).
+		First(&attendance).Error
+	if err != nil {
+		return nil, err
+	}
+	return &attendance, nil
+}
+
+func (r *sessionAttendanceRepository) ListBySession(sessionID uint) ([]models.SessionAttendance, error) {
+	var attendances []models.SessionAttendance
+	err := r.db.Where("session_id = ?", sessionID).
+		Order("check_in_time ASC").
+		Find(&attendances).Error
+	return attendances, err
+}
+
+func (r *sessionAttendanceRepository) ListByEvent(eventID uint) ([]models.SessionAttendance, error) {
+	var attendances []models.SessionAttendance
+	err := r.db.Where("event_id = ?", eventID).
+		Order("check_in_time ASC").
+		Find(&attendances).Error
+	return attendances, err
+}
+
+func (r *sessionAttendanceRepository) Update(attendance *models.SessionAttendance) error {
+	return r.db.Save(attendance).Error
+}
@@ -0,0 +1,39 @@
+package repositories
+
+import (
+	"hackathon-platform/backend/models"
+
+	"gorm.io/gorm"
+)
+
+// SponsorVotingRuleRepository handles persistence for sponsor voting rules.
+type SponsorVotingRuleRepository interface {
+	ListByEvent(eventID uint) ([]models.SponsorVotingRule, error)
+	ReplaceForEvent(eventID uint, rules []models.SponsorVotingRule) error
+}
+
+type sponsorVotingRuleRepository struct {
+	db *gorm.DB
+}
+
+func NewSponsorVotingRuleRepository(db *gorm.DB) SponsorVotingRuleRepository {
+	return &sponsorVotingRuleRepository{db: db}
+}
+
+func (r *sponsorVotingRuleRepository) ListByEvent(eventID uint) ([]models.SponsorVotingRule, error) {
+	var rules []models.SponsorVotingRule
+	err := r.db.Where("event_id = ?", eventID).Order("id ASC").Find(&rules).Error
+	return rules, err
+}
+
+func (r *sponsorVotingRuleRepository) ReplaceForEvent(eventID uint, rules []models.SponsorVotingRule) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("event_id = ?", eventID).Delete(&models.SponsorVotingRule{}).Error; err != nil {
+			return err
+		}
+		if len(rules) == 0 {
+			return nil
+		}
+		return tx.Create(&rules).Error
+	})
+}
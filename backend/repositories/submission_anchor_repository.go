@@ -0,0 +1,84 @@
+package repositories
+
+import (
+	"hackathon-platform/backend/models"
+
+	"gorm.io/gorm"
+)
+
+// SubmissionAnchorRepository manages event anchors and their inclusion proofs.
+type SubmissionAnchorRepository interface {
+	CreateWithProofs(anchor *models.SubmissionAnchor, proofs []models.SubmissionAnchorProof) error
+	GetByEventID(eventID uint) (*models.SubmissionAnchor, error)
+	GetByID(id uint) (*models.SubmissionAnchor, error)
+	ListByStatus(status models.AnchorStatus) ([]models.SubmissionAnchor, error)
+	Update(anchor *models.SubmissionAnchor) error
+	GetProofBySubmission(submissionID uint) (*models.SubmissionAnchorProof, error)
+}
+
+type submissionAnchorRepository struct {
+	db *gorm.DB
+}
+
+func NewSubmissionAnchorRepository(db *gorm.DB) SubmissionAnchorRepository {
+	return &submissionAnchorRepository{db: db}
+}
+
+// CreateWithProofs stores the anchor and every proof atomically, so an anchor
+// never exists without the proofs needed to check it. An earlier failed
+// build for the event is replaced.
+func (r *submissionAnchorRepository) CreateWithProofs(anchor *models.SubmissionAnchor, proofs []models.SubmissionAnchorProof) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("event_id = ? AND status = ?", anchor.EventID, models.AnchorStatusUnbuilt).
+			Delete(&models.SubmissionAnchor{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(anchor).Error; err != nil {
+			return err
+		}
+		if len(proofs) == 0 {
+			return nil
+		}
+		for i := range proofs {
+			proofs[i].AnchorID = anchor.ID
+		}
+		return tx.Create(&proofs).Error
+	})
+}
+
+func (r *submissionAnchorRepository) GetByEventID(eventID uint) (*models.SubmissionAnchor, error) {
+	var anchor models.SubmissionAnchor
+	err := r.db.Where("event_id = ?", eventID).First(&anchor).Error
+	if err != nil {
+		return nil, err
+	}
+	return &anchor, nil
+}
+
+func (r *submissionAnchorRepository) GetByID(id uint) (*models.SubmissionAnchor, error) {
+	var anchor models.SubmissionAnchor
+	err := r.db.First(&anchor, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &anchor, nil
+}
+
+func (r *submissionAnchorRepository) ListByStatus(status models.AnchorStatus) ([]models.SubmissionAnchor, error) {
+	var anchors []models.SubmissionAnchor
+	err := r.db.Where("status = ?", status).Find(&anchors).Error
+	return anchors, err
+}
+
+func (r *submissionAnchorRepository) Update(anchor *models.SubmissionAnchor) error {
+	return r.db.Save(anchor).Error
+}
+
+func (r *submissionAnchorRepository) GetProofBySubmission(submissionID uint) (*models.SubmissionAnchorProof, error) {
+	var proof models.SubmissionAnchorProof
+	err := r.db.Where("submission_id = ?", submissionID).First(&proof).Error
+	if err != nil {
+		return nil, err
+	}
+	return &proof, nil
+}
@@ -0,0 +1,61 @@
+package repositories
+
+import (
+	"hackathon-platform/backend/models"
+
+	"gorm.io/gorm"
+)
+
+// SubmissionExtensionRepository manages per-team submission deadline extensions.
+type SubmissionExtensionRepository interface {
+	Create(extension *models.SubmissionDeadlineExtension) error
+	GetByID(id uint) (*models.SubmissionDeadlineExtension, error)
+	ListByEvent(eventID uint) ([]models.SubmissionDeadlineExtension, error)
+	GetActive(eventID uint, teamID uint) (*models.SubmissionDeadlineExtension, error)
+	Delete(id uint) error
+}
+
+type submissionExtensionRepository struct {
+	db *gorm.DB
+}
+
+func NewSubmissionExtensionRepository(db *gorm.DB) SubmissionExtensionRepository {
+	return &submissionExtensionRepository{db: db}
+}
+
+func (r *submissionExtensionRepository) Create(extension *models.SubmissionDeadlineExtension) error {
+	return r.db.Create(extension).Error
+}
+
+func (r *submissionExtensionRepository) GetByID(id uint) (*models.SubmissionDeadlineExtension, error) {
+	var extension models.SubmissionDeadlineExtension
+	err := r.db.First(&extension, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &extension, nil
+}
+
+func (r *submissionExtensionRepository) ListByEvent(eventID uint) ([]models.SubmissionDeadlineExtension, error) {
+	var extensions []models.SubmissionDeadlineExtension
+	err := r.db.Where("event_id = ?", eventID).
+		Order("created_at ASC").
+		Find(&extensions).Error
+	return extensions, err
+}
+
+// GetActive returns the most recently granted extension for the team.
+func (r *submissionExtensionRepository) GetActive(eventID uint, teamID uint) (*models.SubmissionDeadlineExtension, error) {
+	var extension models.SubmissionDeadlineExtension
+	err := r.db.Where("event_id = ? AND team_id = ?", eventID, teamID).
+		Order("created_at DESC, id DESC").
+		First(&extension).Error
+	if err != nil {
+		return nil, err
+	}
+	return &extension, nil
+}
+
+func (r *submissionExtensionRepository) Delete(id uint) error {
+	return r.db.Delete(&models.SubmissionDeadlineExtension{}, id).Error
+}
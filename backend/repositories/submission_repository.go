@@ -2,17 +2,21 @@ package repositories
 
 import (
 	"hackathon-platform/backend/models"
+	"time"
 
 	"gorm.io/gorm"
 )
 
 type SubmissionRepository interface {
 	Create(submission *models.Submission) error
+	CreateWithRevision(submission *models.Submission, revision *models.SubmissionRevision) error
 	GetByID(id uint) (*models.Submission, error)
 	GetByEventID(eventID uint) ([]models.Submission, error)
 	GetByTeamAndEvent(teamID uint, eventID uint) (*models.Submission, error)
 	GetAll() ([]models.Submission, error)
+	GetByEventsStartedBefore(start time.Time) ([]models.Submission, error)
 	Update(submission *models.Submission) error
+	UpdateWithRevision(submission *models.Submission, replaceFiles bool, revision *models.SubmissionRevision) error
 	Delete(id uint) error
 }
 
@@ -28,6 +32,18 @@ func (r *submissionRepository) Create(submission *models.Submission) error {
 	return r.db.Create(submission).Error
 }
 
+// CreateWithRevision creates the submission and its first revision in one
+// transaction.
+func (r *submissionRepository) CreateWithRevision(submission *models.Submission, revision *models.SubmissionRevision) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(submission).Error; err != nil {
+			return err
+		}
+		revision.SubmissionID = submission.ID
+		return tx.Create(revision).Error
+	})
+}
+
 func (r *submissionRepository) GetByID(id uint) (*models.Submission, error) {
 	var submission models.Submission
 	err := r.db.
@@ -52,11 +68,13 @@ func (r *submissionRepository) GetByEventID(eventID uint) ([]models.Submission,
 	return submissions, err
 }
 
+// GetByTeamAndEvent returns the team's current submission; withdrawn
+// submissions are ignored so the team can submit again.
 func (r *submissionRepository) GetByTeamAndEvent(teamID uint, eventID uint) (*models.Submission, error) {
 	var submission models.Submission
 	err := r.db.
 		Preload("Files").
-		Where("team_id = ? AND event_id = ?", teamID, eventID).
+		Where("team_id = ? AND event_id = ? AND status <> ?", teamID, eventID, models.SubmissionStatusWithdrawn).
 		First(&submission).Error
 	if err != nil {
 		return nil, err
@@ -74,10 +92,54 @@ func (r *submissionRepository) GetAll() ([]models.Submission, error) {
 	return submissions, err
 }
 
+// GetByEventsStartedBefore returns submissions of events that started
+// before start, e.g. to compare a new event against earlier ones.
+func (r *submissionRepository) GetByEventsStartedBefore(start time.Time) ([]models.Submission, error) {
+	var submissions []models.Submission
+	err := r.db.
+		Preload("Files").
+		Preload("Team").
+		Joins("JOIN events ON events.id = submissions.event_id AND events.deleted_at IS NULL").
+		Where("events.start_time < ?", start).
+		Find(&submissions).Error
+	return submissions, err
+}
+
 func (r *submissionRepository) Update(submission *models.Submission) error {
 	return r.db.Session(&gorm.Session{FullSaveAssociations: true}).Save(submission).Error
 }
 
+// UpdateWithRevision saves the submission and appends revision in one
+// transaction, so saved content always has its revision. When two updates
+// race for the same revision number the loser is rolled back entirely.
+// revision may be nil when the content did not change.
+func (r *submissionRepository) UpdateWithRevision(submission *models.Submission, replaceFiles bool, revision *models.SubmissionRevision) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := saveSubmission(tx, submission, replaceFiles); err != nil {
+			return err
+		}
+		if revision == nil {
+			return nil
+		}
+		return tx.Create(revision).Error
+	})
+}
+
+// saveSubmission saves the submission. With replaceFiles its stored files are
+// replaced with submission.Files, so dropped files do not linger.
+func saveSubmission(tx *gorm.DB, submission *models.Submission, replaceFiles bool) error {
+	if replaceFiles {
+		if err := tx.Where("submission_id = ?", submission.ID).Delete(&models.SubmissionFile{}).Error; err != nil {
+			return err
+		}
+		for i := range submission.Files {
+			submission.Files[i].ID = 0
+			submission.Files[i].SubmissionID = submission.ID
+		}
+	}
+	return tx.Session(&gorm.Session{FullSaveAssociations: true}).Save(submission).Error
+}
+
 func (r *submissionRepository) Delete(id uint) error {
 	return r.db.Delete(&models.Submission{}, id).Error
 }
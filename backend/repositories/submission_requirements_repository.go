@@ -0,0 +1,33 @@
+package repositories
+
+import (
+	"hackathon-platform/backend/models"
+
+	"gorm.io/gorm"
+)
+
+type SubmissionRequirementsRepository interface {
+	GetByEventID(eventID uint) (*models.SubmissionRequirements, error)
+	Save(requirements *models.SubmissionRequirements) error
+}
+
+type submissionRequirementsRepository struct {
+	db *gorm.DB
+}
+
+func NewSubmissionRequirementsRepository(db *gorm.DB) SubmissionRequirementsRepository {
+	return &submissionRequirementsRepository{db: db}
+}
+
+func (r *submissionRequirementsRepository) GetByEventID(eventID uint) (*models.SubmissionRequirements, error) {
+	var requirements models.SubmissionRequirements
+	if err := r.db.Where("event_id = ?", eventID).First(&requirements).Error; err != nil {
+		return nil, err
+	}
+	return &requirements, nil
+}
+
+// Save creates the event's requirements or replaces the existing ones.
+func (r *submissionRequirementsRepository) Save(requirements *models.SubmissionRequirements) error {
+	return r.db.Save(requirements).Error
+}
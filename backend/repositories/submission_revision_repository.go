@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"hackathon-platform/backend/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// SubmissionRevisionRepository stores submission revisions. Revisions are
+// append-only, so there is no update or delete.
+type SubmissionRevisionRepository interface {
+	Create(revision *models.SubmissionRevision) error
+	ListBySubmission(submissionID uint) ([]models.SubmissionRevision, error)
+	GetBySubmissionAndNumber(submissionID uint, revision int) (*models.SubmissionRevision, error)
+	GetLatest(submissionID uint) (*models.SubmissionRevision, error)
+	GetLatestAt(submissionID uint, at time.Time) (*models.SubmissionRevision, error)
+}
+
+type submissionRevisionRepository struct {
+	db *gorm.DB
+}
+
+func NewSubmissionRevisionRepository(db *gorm.DB) SubmissionRevisionRepository {
+	return &submissionRevisionRepository{db: db}
+}
+
+func (r *submissionRevisionRepository) Create(revision *models.SubmissionRevision) error {
+	return r.db.Create(revision).Error
+}
+
+func (r *submissionRevisionRepository) ListBySubmission(submissionID uint) ([]models.SubmissionRevision, error) {
+	var revisions []models.SubmissionRevision
+	err := r.db.Where("submission_id = ?", submissionID).
+		Order("revision ASC").
+		Find(&revisions).Error
+	return revisions, err
+}
+
+func (r *submissionRevisionRepository) GetBySubmissionAndNumber(submissionID uint, revision int) (*models.SubmissionRevision, error) {
+	var rev models.SubmissionRevision
+	err := r.db.Where("submission_id = ? AND revision = ?", submissionID, revision).
+		First(&rev).Error
+	if err != nil {
+		return nil, err
+	}
+	return &rev, nil
+}
+
+func (r *submissionRevisionRepository) GetLatest(submissionID uint) (*models.SubmissionRevision, error) {
+	var rev models.SubmissionRevision
+	err := r.db.Where("submission_id = ?", submissionID).
+		Order("revision DESC").
+		First(&rev).Error
+	if err != nil {
+		return nil, err
+	}
+	return &rev, nil
+}
+
+func (r *submissionRevisionRepository) GetLatestAt(submissionID uint, at time.Time) (*models.SubmissionRevision, error) {
+	var rev models.SubmissionRevision
+	err := r.db.Where("submission_id = ? AND created_at <= ?", submissionID, at).
+		Order("revision DESC").
+		First(&rev).Error
+	if err != nil {
+		return nil, err
+	}
+	return &rev, nil
+}
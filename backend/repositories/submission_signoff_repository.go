@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"hackathon-platform/backend/models"
+
+	"gorm.io/gorm"
+)
+
+// SubmissionSignoffRepository stores member signoffs on submission content.
+type SubmissionSignoffRepository interface {
+	Create(signoff *models.SubmissionSignoff) error
+	ListBySubmission(submissionID uint) ([]models.SubmissionSignoff, error)
+	ListBySubmissionAndHash(submissionID uint, hash string) ([]models.SubmissionSignoff, error)
+}
+
+type submissionSignoffRepository struct {
+	db *gorm.DB
+}
+
+func NewSubmissionSignoffRepository(db *gorm.DB) SubmissionSignoffRepository {
+	return &submissionSignoffRepository{db: db}
+}
+
+func (r *submissionSignoffRepository) Create(signoff *models.SubmissionSignoff) error {
+	return r.db.Create(signoff).Error
+}
+
+func (r *submissionSignoffRepository) ListBySubmission(submissionID uint) ([]models.SubmissionSignoff, error) {
+	var signoffs []models.SubmissionSignoff
+	err := r.db.Where("submission_id = ?", submissionID).Order("created_at ASC").Find(&signoffs).Error
+	return signoffs, err
+}
+
+func (r *submissionSignoffRepository) ListBySubmissionAndHash(submissionID uint, hash string) ([]models.SubmissionSignoff, error) {
+	var signoffs []models.SubmissionSignoff
+	err := r.db.Where("submission_id = ? AND submission_hash = ?", submissionID, hash).
+		Order("created_at ASC").
+		Find(&signoffs).Error
+	return signoffs, err
+}
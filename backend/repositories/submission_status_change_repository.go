@@ -0,0 +1,32 @@
+package repositories
+
+import (
+	"hackathon-platform/backend/models"
+
+	"gorm.io/gorm"
+)
+
+// SubmissionStatusChangeRepository stores the append-only status history of
+// submissions.
+type SubmissionStatusChangeRepository interface {
+	Create(change *models.SubmissionStatusChange) error
+	ListBySubmission(submissionID uint) ([]models.SubmissionStatusChange, error)
+}
+
+type submissionStatusChangeRepository struct {
+	db *gorm.DB
+}
+
+func NewSubmissionStatusChangeRepository(db *gorm.DB) SubmissionStatusChangeRepository {
+	return &submissionStatusChangeRepository{db: db}
+}
+
+func (r *submissionStatusChangeRepository) Create(change *models.SubmissionStatusChange) error {
+	return r.db.Create(change).Error
+}
+
+func (r *submissionStatusChangeRepository) ListBySubmission(submissionID uint) ([]models.SubmissionStatusChange, error) {
+	var changes []models.SubmissionStatusChange
+	err := r.db.Where("submission_id = ?", submissionID).Order("id ASC").Find(&changes).Error
+	return changes, err
+}
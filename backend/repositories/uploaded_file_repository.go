@@ -0,0 +1,53 @@
+package repositories
+
+import (
+	"hackathon-platform/backend/models"
+
+	"gorm.io/gorm"
+)
+
+// UploadedFileRepository manages upload records for content-addressed blobs.
+type UploadedFileRepository interface {
+	Create(file *models.UploadedFile) error
+	GetByEventAndCID(eventID uint, cid string) (*models.UploadedFile, error)
+	GetByCID(cid string) (*models.UploadedFile, error)
+	ListByEvent(eventID uint) ([]models.UploadedFile, error)
+}
+
+type uploadedFileRepository struct {
+	db *gorm.DB
+}
+
+func NewUploadedFileRepository(db *gorm.DB) UploadedFileRepository {
+	return &uploadedFileRepository{db: db}
+}
+
+func (r *uploadedFileRepository) Create(file *models.UploadedFile) error {
+	return r.db.Create(file).Error
+}
+
+func (r *uploadedFileRepository) GetByEventAndCID(eventID uint, cid string) (*models.UploadedFile, error) {
+	var file models.UploadedFile
+	err := r.db.Where("event_id = ? AND cid = ?", eventID, cid).First(&file).Error
+	if err != nil {
+		return nil, err
+	}
+	return &file, nil
+}
+
+func (r *uploadedFileRepository) GetByCID(cid string) (*models.UploadedFile, error) {
+	var file models.UploadedFile
+	err := r.db.Where("cid = ?", cid).Order("created_at ASC").First(&file).Error
+	if err != nil {
+		return nil, err
+	}
+	return &file, nil
+}
+
+func (r *uploadedFileRepository) ListByEvent(eventID uint) ([]models.UploadedFile, error) {
+	var files []models.UploadedFile
+	err := r.db.Where("event_id = ?", eventID).
+		Order("created_at DESC").
+		Find(&files).Error
+	return files, err
+}
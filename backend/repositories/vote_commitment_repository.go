@@ -0,0 +1,71 @@
+package repositories
+
+import (
+	"hackathon-platform/backend/models"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// VoteCommitmentRepository handles persistence for commit-reveal votes.
+type VoteCommitmentRepository interface {
+	Create(commitment *models.VoteCommitment) error
+	GetByCommitment(eventID uint, voterAddress string, commitment string) (*models.VoteCommitment, error)
+	ListByVoter(eventID uint, voterAddress string) ([]models.VoteCommitment, error)
+	CountByVoter(eventID uint, voterAddress string) (int64, error)
+	CountByEvent(eventID uint) (committed int64, revealed int64, err error)
+	MarkRevealed(id uint, voteID uint, at time.Time) error
+}
+
+type voteCommitmentRepository struct {
+	db *gorm.DB
+}
+
+func NewVoteCommitmentRepository(db *gorm.DB) VoteCommitmentRepository {
+	return &voteCommitmentRepository{db: db}
+}
+
+func (r *voteCommitmentRepository) Create(commitment *models.VoteCommitment) error {
+	return r.db.Create(commitment).Error
+}
+
+func (r *voteCommitmentRepository) GetByCommitment(eventID uint, voterAddress string, commitment string) (*models.VoteCommitment, error) {
+	var record models.VoteCommitment
+	err := r.db.Where("event_id = ? AND voter_address = ? AND commitment = ?", eventID, voterAddress, commitment).
+		First(&record).Error
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+func (r *voteCommitmentRepository) ListByVoter(eventID uint, voterAddress string) ([]models.VoteCommitment, error) {
+	var commitments []models.VoteCommitment
+	err := r.db.Where("event_id = ? AND voter_address = ?", eventID, voterAddress).
+		Order("created_at ASC").
+		Find(&commitments).Error
+	return commitments, err
+}
+
+func (r *voteCommitmentRepository) CountByVoter(eventID uint, voterAddress string) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.VoteCommitment{}).
+		Where("event_id = ? AND voter_address = ?", eventID, voterAddress).
+		Count(&count).Error
+	return count, err
+}
+
+func (r *voteCommitmentRepository) CountByEvent(eventID uint) (int64, int64, error) {
+	var committed, revealed int64
+	if err := r.db.Model(&models.VoteCommitment{}).Where("event_id = ?", eventID).Count(&committed).Error; err != nil {
+		return 0, 0, err
+	}
+	err := r.db.Model(&models.VoteCommitment{}).Where("event_id = ? AND revealed = ?", eventID, true).Count(&revealed).Error
+	return committed, revealed, err
+}
+
+func (r *voteCommitmentRepository) MarkRevealed(id uint, voteID uint, at time.Time) error {
+	return r.db.Model(&models.VoteCommitment{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{"revealed": true, "vote_id": voteID, "revealed_at": at}).Error
+}
@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"errors"
+	"hackathon-platform/backend/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// VoteNonceRepository hands out and consumes signed vote nonces.
+type VoteNonceRepository interface {
+	Next(eventID uint, voterAddress string) (uint64, error)
+	Consume(eventID uint, voterAddress string, nonce uint64) (bool, error)
+}
+
+type voteNonceRepository struct {
+	db *gorm.DB
+}
+
+func NewVoteNonceRepository(db *gorm.DB) VoteNonceRepository {
+	return &voteNonceRepository{db: db}
+}
+
+// Next returns the nonce the voter's next signed vote must carry.
+func (r *voteNonceRepository) Next(eventID uint, voterAddress string) (uint64, error) {
+	var record models.VoteNonce
+	err := r.db.Where("event_id = ? AND voter_address = ?", eventID, voterAddress).First(&record).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return record.NextNonce, nil
+}
+
+// Consume advances the voter's nonce if nonce is the expected one. It
+// reports false when the nonce was already used or is not the next one.
+func (r *voteNonceRepository) Consume(eventID uint, voterAddress string, nonce uint64) (bool, error) {
+	if nonce == 0 {
+		result := r.db.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&models.VoteNonce{EventID: eventID, VoterAddress: voterAddress, NextNonce: 1})
+		return result.RowsAffected == 1, result.Error
+	}
+	result := r.db.Model(&models.VoteNonce{}).
+		Where("event_id = ? AND voter_address = ? AND next_nonce = ?", eventID, voterAddress, nonce).
+		Update("next_nonce", gorm.Expr("next_nonce + 1"))
+	return result.RowsAffected == 1, result.Error
+}
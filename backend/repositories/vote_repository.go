@@ -1,20 +1,25 @@
 package repositories
 
 import (
+	"errors"
 	"hackathon-platform/backend/models"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // VoteRepository handles persistence for votes.
 type VoteRepository interface {
 	Create(vote *models.Vote) error
+	CreateWithinBudget(vote *models.Vote, budget int) (bool, error)
 	GetByID(id uint) (*models.Vote, error)
 	GetByEventID(eventID uint) ([]models.Vote, error)
 	GetBySubmissionID(submissionID uint) ([]models.Vote, error)
 	Delete(id uint) error
 	CountByEventAndVoter(eventID uint, address string, voterType models.VoterType) (int64, error)
 	CountBySubmissionAndVoter(submissionID uint, address string, voterType models.VoterType) (int64, error)
+	GetByEventAndVoter(eventID uint, address string, voterType models.VoterType) ([]models.Vote, error)
+	SumCreditsByEventAndVoter(eventID uint, address string) (int64, error)
 	GetSummaryByEvent(eventID uint) ([]VoteSummaryRow, error)
 }
 
@@ -26,6 +31,7 @@ type VoteSummaryRow struct {
 	JudgeWeight     float64 `json:"judge_weight"`
 	SponsorWeight   float64 `json:"sponsor_weight"`
 	PublicWeight    float64 `json:"public_weight"`
+	PublicCredits   int64   `json:"public_credits"`
 	VoteCount       int64   `json:"vote_count"`
 }
 
@@ -41,6 +47,46 @@ func (r *voteRepository) Create(vote *models.Vote) error {
 	return r.db.Create(vote).Error
 }
 
+// CreateWithinBudget stores a quadratic vote if the voter's credits spent
+// on the event plus the vote's credits stay within budget. It reports false
+// without storing anything when they would not. The voter's credit account
+// is locked for the check and the insert, so concurrent votes cannot both
+// spend the same remaining credits.
+func (r *voteRepository) CreateWithinBudget(vote *models.Vote, budget int) (bool, error) {
+	if vote.Credits < 0 {
+		return false, errors.New("vote credits cannot be negative")
+	}
+	created := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		account := models.VoteCreditAccount{EventID: vote.EventID, VoterAddress: vote.VoterAddress}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&account).Error; err != nil {
+			return err
+		}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("event_id = ? AND voter_address = ?", vote.EventID, vote.VoterAddress).
+			First(&account).Error; err != nil {
+			return err
+		}
+
+		var spent int64
+		if err := tx.Model(&models.Vote{}).
+			Select("COALESCE(SUM(credits), 0)").
+			Where("event_id = ? AND voter_address = ? AND voter_type = ?", vote.EventID, vote.VoterAddress, models.VoterTypePublic).
+			Scan(&spent).Error; err != nil {
+			return err
+		}
+		if spent+int64(vote.Credits) > int64(budget) {
+			return nil
+		}
+		if err := tx.Create(vote).Error; err != nil {
+			return err
+		}
+		created = true
+		return nil
+	})
+	return created, err
+}
+
 func (r *voteRepository) GetByID(id uint) (*models.Vote, error) {
 	var vote models.Vote
 	err := r.db.Preload("Submission").First(&vote, id).Error
@@ -88,6 +134,23 @@ func (r *voteRepository) CountBySubmissionAndVoter(submissionID uint, address st
 	return count, err
 }
 
+func (r *voteRepository) GetByEventAndVoter(eventID uint, address string, voterType models.VoterType) ([]models.Vote, error) {
+	var votes []models.Vote
+	err := r.db.Where("event_id = ? AND voter_address = ? AND voter_type = ?", eventID, address, voterType).
+		Order("created_at ASC").
+		Find(&votes).Error
+	return votes, err
+}
+
+func (r *voteRepository) SumCreditsByEventAndVoter(eventID uint, address string) (int64, error) {
+	var total int64
+	err := r.db.Model(&models.Vote{}).
+		Select("COALESCE(SUM(credits), 0)").
+		Where("event_id = ? AND voter_address = ? AND voter_type = ?", eventID, address, models.VoterTypePublic).
+		Scan(&total).Error
+	return total, err
+}
+
 func (r *voteRepository) GetSummaryByEvent(eventID uint) ([]VoteSummaryRow, error) {
 	var rows []VoteSummaryRow
 	err := r.db.
@@ -99,10 +162,12 @@ func (r *voteRepository) GetSummaryByEvent(eventID uint) ([]VoteSummaryRow, erro
 			COALESCE(SUM(CASE WHEN voter_type = ? THEN weight ELSE 0 END), 0) as judge_weight,
 			COALESCE(SUM(CASE WHEN voter_type = ? THEN weight ELSE 0 END), 0) as sponsor_weight,
 			COALESCE(SUM(CASE WHEN voter_type = ? THEN weight ELSE 0 END), 0) as public_weight,
+			COALESCE(SUM(CASE WHEN voter_type = ? THEN credits ELSE 0 END), 0) as public_credits,
 			COUNT(*) as vote_count`,
 			models.VoterTypeJudge,
 			models.VoterTypeSponsor,
 			models.VoterTypePublic,
+			models.VoterTypePublic,
 		).
 		Joins("JOIN submissions ON submissions.id = votes.submission_id").
 		Where("votes.event_id = ?", eventID).
@@ -0,0 +1,366 @@
+package services
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"hackathon-platform/backend/models"
+	"hackathon-platform/backend/repositories"
+	"sort"
+	"time"
+)
+
+const (
+	maxAnchorAttempts = 5
+	anchorTimeout     = 30 * time.Second
+)
+
+var errNothingToAnchor = errors.New("no submissions to anchor")
+
+type AnchorService interface {
+	AnchorEvent(eventID uint, organizerAddress string) (*models.SubmissionAnchor, error)
+	GetAnchor(eventID uint) (*models.SubmissionAnchor, error)
+	GetSubmissionProof(submissionID uint) (*SubmissionAnchorProofView, error)
+	AnchorDue(now time.Time)
+}
+
+type anchorService struct {
+	anchorRepo     repositories.SubmissionAnchorRepository
+	eventRepo      repositories.EventRepository
+	submissionRepo repositories.SubmissionRepository
+	revisionRepo   repositories.SubmissionRevisionRepository
+	extensionRepo  repositories.SubmissionExtensionRepository
+	anchorer       Anchorer
+}
+
+// NewAnchorService builds the anchoring service. anchorer may be nil, in
+// which case trees and proofs are still built but stay pending.
+func NewAnchorService(
+	anchorRepo repositories.SubmissionAnchorRepository,
+	eventRepo repositories.EventRepository,
+	submissionRepo repositories.SubmissionRepository,
+	revisionRepo repositories.SubmissionRevisionRepository,
+	extensionRepo repositories.SubmissionExtensionRepository,
+	anchorer Anchorer,
+) AnchorService {
+	return &anchorService{
+		anchorRepo:     anchorRepo,
+		eventRepo:      eventRepo,
+		submissionRepo: submissionRepo,
+		revisionRepo:   revisionRepo,
+		extensionRepo:  extensionRepo,
+		anchorer:       anchorer,
+	}
+}
+
+// AnchorLeaf is the canonical leaf committed for each submission.
+type AnchorLeaf struct {
+	EventID        uint   `json:"event_id"`
+	SubmissionID   uint   `json:"submission_id"`
+	TeamID         uint   `json:"team_id"`
+	Revision       int    `json:"revision"`
+	SubmissionHash string `json:"submission_hash"`
+}
+
+// SubmissionAnchorProofView is a stored proof plus the anchor it leads to.
+type SubmissionAnchorProofView struct {
+	SubmissionID   uint                     `json:"submission_id"`
+	Revision       int                      `json:"revision"`
+	SubmissionHash string                   `json:"submission_hash"`
+	LeafIndex      int                      `json:"leaf_index"`
+	LeafData       string                   `json:"leaf_data"`
+	LeafHash       string                   `json:"leaf_hash"`
+	Proof          []string                 `json:"proof"`
+	Valid          bool                     `json:"valid"` // Proof recomputes the anchored root
+	Anchor         *models.SubmissionAnchor `json:"anchor"`
+}
+
+func (s *anchorService) AnchorEvent(eventID uint, organizerAddress string) (*models.SubmissionAnchor, error) {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+	if normalizeAddress(event.OrganizerAddress) != normalizeAddress(organizerAddress) {
+		return nil, errors.New("only the organizer can anchor submissions")
+	}
+
+	anchor, err := s.anchorRepo.GetByEventID(eventID)
+	if err != nil || anchor.Status == models.AnchorStatusUnbuilt {
+		cutoff, ok := s.anchorCutoff(event)
+		if !ok || time.Now().Before(cutoff) {
+			return nil, errors.New("submission window has not closed yet")
+		}
+		previous := anchor
+		if anchor, err = s.buildAnchor(event, cutoff, organizerAddress); err != nil {
+			if err != errNothingToAnchor {
+				s.recordBuildFailure(event, cutoff, organizerAddress, previous, err)
+			}
+			return nil, err
+		}
+	} else if anchor.Status == models.AnchorStatusEmpty {
+		return nil, errNothingToAnchor
+	} else if anchor.Status == models.AnchorStatusSubmitted || anchor.Status == models.AnchorStatusConfirmed {
+		return nil, errors.New("submissions for this event are already anchored")
+	}
+
+	if s.anchorer == nil {
+		return anchor, nil
+	}
+	if err := s.publish(anchor); err != nil {
+		return nil, err
+	}
+	return anchor, nil
+}
+
+func (s *anchorService) GetAnchor(eventID uint) (*models.SubmissionAnchor, error) {
+	return s.anchorRepo.GetByEventID(eventID)
+}
+
+func (s *anchorService) GetSubmissionProof(submissionID uint) (*SubmissionAnchorProofView, error) {
+	proof, err := s.anchorRepo.GetProofBySubmission(submissionID)
+	if err != nil {
+		return nil, err
+	}
+	anchor, err := s.anchorRepo.GetByID(proof.AnchorID)
+	if err != nil {
+		return nil, err
+	}
+
+	var siblings []string
+	if err := json.Unmarshal([]byte(proof.Proof), &siblings); err != nil {
+		return nil, err
+	}
+
+	view := &SubmissionAnchorProofView{
+		SubmissionID:   proof.SubmissionID,
+		Revision:       proof.Revision,
+		SubmissionHash: proof.SubmissionHash,
+		LeafIndex:      proof.LeafIndex,
+		LeafData:       proof.LeafData,
+		LeafHash:       proof.LeafHash,
+		Proof:          siblings,
+		Anchor:         anchor,
+	}
+
+	root, rootErr := decodeHash(anchor.Root)
+	path, pathErr := decodeHashes(siblings)
+	if rootErr == nil && pathErr == nil {
+		view.Valid = verifyMerkleInclusion(merkleLeafHash([]byte(proof.LeafData)), proof.LeafIndex, anchor.TreeSize, path, root)
+	}
+	return view, nil
+}
+
+// AnchorDue is run periodically. It builds trees for events whose submission
+// window has closed, (re)publishes unpublished roots and records receipts.
+// Events without submissions and failed builds are recorded on the anchor
+// row, so they are not rebuilt on every run.
+func (s *anchorService) AnchorDue(now time.Time) {
+	events, err := s.eventRepo.ListSubmissionClosedBefore(now)
+	if err == nil {
+		for i := range events {
+			event := &events[i]
+			existing, err := s.anchorRepo.GetByEventID(event.ID)
+			if err == nil && (existing.Status != models.AnchorStatusUnbuilt || existing.Attempts >= maxAnchorAttempts) {
+				continue
+			}
+			cutoff, ok := s.anchorCutoff(event)
+			if !ok || now.Before(cutoff) {
+				continue
+			}
+			if _, err := s.buildAnchor(event, cutoff, ""); err != nil && err != errNothingToAnchor {
+				s.recordBuildFailure(event, cutoff, "", existing, err)
+			}
+		}
+	}
+
+	if s.anchorer == nil {
+		return
+	}
+
+	for _, status := range []models.AnchorStatus{models.AnchorStatusPending, models.AnchorStatusFailed} {
+		anchors, err := s.anchorRepo.ListByStatus(status)
+		if err != nil {
+			continue
+		}
+		for i := range anchors {
+			if anchors[i].Attempts >= maxAnchorAttempts {
+				continue
+			}
+			s.publish(&anchors[i])
+		}
+	}
+
+	submitted, err := s.anchorRepo.ListByStatus(models.AnchorStatusSubmitted)
+	if err != nil {
+		return
+	}
+	for i := range submitted {
+		s.checkReceipt(&submitted[i])
+	}
+}
+
+// anchorCutoff is the moment after which no team can change its submission:
+// the latest deadline across extensions, plus the grace period.
+func (s *anchorService) anchorCutoff(event *models.Event) (time.Time, bool) {
+	extensions, err := s.extensionRepo.ListByEvent(event.ID)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return eventSubmissionCutoff(event, extensions)
+}
+
+// buildAnchor commits every submission's fingerprint as of the cutoff.
+func (s *anchorService) buildAnchor(event *models.Event, cutoff time.Time, triggeredBy string) (*models.SubmissionAnchor, error) {
+	submissions, err := s.submissionRepo.GetByEventID(event.ID)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(submissions, func(i, j int) bool {
+		return submissions[i].ID < submissions[j].ID
+	})
+
+	var leaves []AnchorLeaf
+	for _, submission := range submissions {
+		// Drafts the team never finalized are not part of the event
+		if !submissionFinalized(submission.Status) {
+			continue
+		}
+		leaf := AnchorLeaf{
+			EventID:      event.ID,
+			SubmissionID: submission.ID,
+			TeamID:       submission.TeamID,
+		}
+		if revision, err := s.revisionRepo.GetLatestAt(submission.ID, cutoff); err == nil {
+			leaf.Revision = revision.Revision
+			leaf.SubmissionHash = revision.RevisionHash
+		} else if !submission.SubmittedAt.After(cutoff) {
+			// Submissions without revision history are anchored as they are now
+			leaf.Revision = submission.CurrentRevision
+			leaf.SubmissionHash = submission.SubmissionHash
+		} else {
+			continue
+		}
+		leaves = append(leaves, leaf)
+	}
+	if len(leaves) == 0 {
+		empty := &models.SubmissionAnchor{
+			EventID:     event.ID,
+			Cutoff:      cutoff,
+			Status:      models.AnchorStatusEmpty,
+			LastError:   errNothingToAnchor.Error(),
+			TriggeredBy: triggeredBy,
+		}
+		if err := s.anchorRepo.CreateWithProofs(empty, nil); err != nil {
+			return nil, err
+		}
+		return nil, errNothingToAnchor
+	}
+
+	data := make([][]byte, len(leaves))
+	hashes := make([][]byte, len(leaves))
+	for i, leaf := range leaves {
+		if data[i], err = canonicalJSON(leaf); err != nil {
+			return nil, err
+		}
+		hashes[i] = merkleLeafHash(data[i])
+	}
+
+	anchor := &models.SubmissionAnchor{
+		EventID:     event.ID,
+		Root:        hex.EncodeToString(merkleRoot(hashes)),
+		TreeSize:    len(hashes),
+		Cutoff:      cutoff,
+		Status:      models.AnchorStatusPending,
+		TriggeredBy: triggeredBy,
+	}
+
+	proofs := make([]models.SubmissionAnchorProof, len(leaves))
+	for i, leaf := range leaves {
+		path, err := json.Marshal(encodeHashes(merkleInclusionProof(hashes, i)))
+		if err != nil {
+			return nil, err
+		}
+		proofs[i] = models.SubmissionAnchorProof{
+			EventID:        event.ID,
+			SubmissionID:   leaf.SubmissionID,
+			Revision:       leaf.Revision,
+			SubmissionHash: leaf.SubmissionHash,
+			LeafIndex:      i,
+			LeafData:       string(data[i]),
+			LeafHash:       hex.EncodeToString(hashes[i]),
+			Proof:          string(path),
+		}
+	}
+
+	if err := s.anchorRepo.CreateWithProofs(anchor, proofs); err != nil {
+		return nil, err
+	}
+	return anchor, nil
+}
+
+// recordBuildFailure stores why the event's tree could not be built. previous
+// is the earlier failed build, if any, whose attempts carry over.
+func (s *anchorService) recordBuildFailure(event *models.Event, cutoff time.Time, triggeredBy string, previous *models.SubmissionAnchor, buildErr error) {
+	failure := &models.SubmissionAnchor{
+		EventID:     event.ID,
+		Cutoff:      cutoff,
+		Status:      models.AnchorStatusUnbuilt,
+		Attempts:    1,
+		LastError:   buildErr.Error(),
+		TriggeredBy: triggeredBy,
+	}
+	if previous != nil {
+		failure.Attempts = previous.Attempts + 1
+	}
+	s.anchorRepo.CreateWithProofs(failure, nil)
+}
+
+func (s *anchorService) publish(anchor *models.SubmissionAnchor) error {
+	root, err := decodeHash(anchor.Root)
+	if err != nil {
+		return err
+	}
+	var root32 [32]byte
+	copy(root32[:], root)
+
+	ctx, cancel := context.WithTimeout(context.Background(), anchorTimeout)
+	defer cancel()
+
+	anchor.Attempts++
+	anchor.ChainID = s.anchorer.ChainID()
+	anchor.ContractAddress = s.anchorer.ContractAddress()
+	txHash, err := s.anchorer.Publish(ctx, anchor.EventID, root32, anchor.TreeSize)
+	if err != nil {
+		anchor.Status = models.AnchorStatusFailed
+		anchor.LastError = err.Error()
+	} else {
+		anchor.Status = models.AnchorStatusSubmitted
+		anchor.TxHash = txHash
+		anchor.LastError = ""
+	}
+
+	if updateErr := s.anchorRepo.Update(anchor); updateErr != nil {
+		return updateErr
+	}
+	return err
+}
+
+func (s *anchorService) checkReceipt(anchor *models.SubmissionAnchor) {
+	ctx, cancel := context.WithTimeout(context.Background(), anchorTimeout)
+	defer cancel()
+
+	receipt, err := s.anchorer.Receipt(ctx, anchor.TxHash)
+	if err != nil || !receipt.Mined {
+		return
+	}
+
+	if receipt.Success {
+		anchor.Status = models.AnchorStatusConfirmed
+		anchor.BlockNumber = &receipt.BlockNumber
+	} else {
+		anchor.Status = models.AnchorStatusFailed
+		anchor.LastError = "anchor transaction reverted"
+	}
+	s.anchorRepo.Update(anchor)
+}
@@ -0,0 +1,175 @@
+package services
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// anchorABI describes SubmissionRegistry.anchorSubmissionRoot. The same
+// calldata is used when no contract is configured, so plain calldata anchors
+// decode with the same ABI.
+const anchorABI = `[{"type":"function","name":"anchorSubmissionRoot","stateMutability":"nonpayable","inputs":[{"name":"eventId","type":"uint256"},{"name":"root","type":"bytes32"},{"name":"submissionCount","type":"uint256"}],"outputs":[]}]`
+
+// AnchorReceipt reports the on-chain outcome of an anchor transaction.
+type AnchorReceipt struct {
+	Mined       bool
+	Success     bool
+	BlockNumber uint64
+}
+
+// Anchorer publishes event roots on-chain.
+type Anchorer interface {
+	Publish(ctx context.Context, eventID uint, root [32]byte, submissionCount int) (txHash string, err error)
+	Receipt(ctx context.Context, txHash string) (*AnchorReceipt, error)
+	ChainID() string
+	ContractAddress() string
+}
+
+type ethAnchorer struct {
+	client   *ethclient.Client
+	key      *ecdsa.PrivateKey
+	from     common.Address
+	contract *common.Address
+	chainID  *big.Int
+	abi      abi.ABI
+}
+
+// NewEthAnchorer connects to an Ethereum JSON-RPC endpoint. With an empty
+// contractAddress roots are sent as calldata to the operator's own address.
+func NewEthAnchorer(rpcURL, privateKeyHex, contractAddress string) (Anchorer, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(privateKeyHex), "0x"))
+	if err != nil {
+		return nil, errors.New("invalid anchor private key")
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(anchorABI))
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := ethclient.Dial(rpcURL)
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := client.ChainID(context.Background())
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+
+	a := &ethAnchorer{
+		client:  client,
+		key:     key,
+		from:    crypto.PubkeyToAddress(key.PublicKey),
+		chainID: chainID,
+		abi:     parsed,
+	}
+	if contractAddress != "" {
+		if !common.IsHexAddress(contractAddress) {
+			client.Close()
+			return nil, errors.New("invalid anchor contract address")
+		}
+		addr := common.HexToAddress(contractAddress)
+		a.contract = &addr
+	}
+	return a, nil
+}
+
+func (a *ethAnchorer) ChainID() string {
+	return a.chainID.String()
+}
+
+func (a *ethAnchorer) ContractAddress() string {
+	if a.contract == nil {
+		return ""
+	}
+	return a.contract.Hex()
+}
+
+func (a *ethAnchorer) Publish(ctx context.Context, eventID uint, root [32]byte, submissionCount int) (string, error) {
+	data, err := a.abi.Pack("anchorSubmissionRoot", new(big.Int).SetUint64(uint64(eventID)), root, big.NewInt(int64(submissionCount)))
+	if err != nil {
+		return "", err
+	}
+
+	to := a.from
+	if a.contract != nil {
+		to = *a.contract
+	}
+
+	nonce, err := a.client.PendingNonceAt(ctx, a.from)
+	if err != nil {
+		return "", err
+	}
+	gas, err := a.client.EstimateGas(ctx, ethereum.CallMsg{From: a.from, To: &to, Data: data})
+	if err != nil {
+		return "", err
+	}
+	head, err := a.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var tx *types.Transaction
+	if head.BaseFee == nil {
+		// Chains without EIP-1559 only take legacy gas-priced transactions
+		gasPrice, err := a.client.SuggestGasPrice(ctx)
+		if err != nil {
+			return "", err
+		}
+		tx = types.NewTx(&types.LegacyTx{
+			Nonce:    nonce,
+			GasPrice: gasPrice,
+			Gas:      gas,
+			To:       &to,
+			Data:     data,
+		})
+	} else {
+		tip, err := a.client.SuggestGasTipCap(ctx)
+		if err != nil {
+			return "", err
+		}
+		tx = types.NewTx(&types.DynamicFeeTx{
+			ChainID:   a.chainID,
+			Nonce:     nonce,
+			GasTipCap: tip,
+			GasFeeCap: new(big.Int).Add(tip, new(big.Int).Mul(head.BaseFee, big.NewInt(2))),
+			Gas:       gas,
+			To:        &to,
+			Data:      data,
+		})
+	}
+	signed, err := types.SignTx(tx, types.LatestSignerForChainID(a.chainID), a.key)
+	if err != nil {
+		return "", err
+	}
+	if err := a.client.SendTransaction(ctx, signed); err != nil {
+		return "", err
+	}
+	return signed.Hash().Hex(), nil
+}
+
+func (a *ethAnchorer) Receipt(ctx context.Context, txHash string) (*AnchorReceipt, error) {
+	receipt, err := a.client.TransactionReceipt(ctx, common.HexToHash(txHash))
+	if errors.Is(err, ethereum.NotFound) {
+		return &AnchorReceipt{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &AnchorReceipt{
+		Mined:       true,
+		Success:     receipt.Status == types.ReceiptStatusSuccessful,
+		BlockNumber: receipt.BlockNumber.Uint64(),
+	}, nil
+}
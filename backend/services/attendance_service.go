@@ -0,0 +1,516 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"hackathon-platform/backend/models"
+	"hackathon-platform/backend/repositories"
+	"sort"
+	"strings"
+	"time"
+)
+
+// sessionEarlyCheckIn is how long before a session starts participants may check in.
+const sessionEarlyCheckIn = 30 * time.Minute
+
+// AttendanceService manages multi-session attendance and the eligibility
+// rules derived from it.
+type AttendanceService interface {
+	CreateSession(eventID uint, req *CreateSessionRequest) (*models.AttendanceSession, error)
+	ListSessions(eventID uint) ([]models.AttendanceSession, error)
+	UpdateSession(sessionID uint, req *UpdateSessionRequest) (*models.AttendanceSession, error)
+	DeleteSession(sessionID uint, organizerAddress string) error
+	CheckIn(sessionID uint, req *SessionAttendanceRequest) (*models.SessionAttendance, error)
+	CheckOut(sessionID uint, req *SessionAttendanceRequest) (*models.SessionAttendance, error)
+	ListAttendees(sessionID uint) ([]models.SessionAttendance, error)
+	GetAttendanceReport(eventID uint) (*AttendanceReport, error)
+	GetTeamEligibility(eventID uint, teamID uint) (*TeamEligibility, error)
+	GetEventEligibility(eventID uint) (map[uint]TeamEligibility, error)
+}
+
+type attendanceService struct {
+	eventRepo        repositories.EventRepository
+	sessionRepo      repositories.AttendanceSessionRepository
+	attendanceRepo   repositories.SessionAttendanceRepository
+	checkInRepo      repositories.CheckInRepository
+	teamRepo         repositories.TeamRepository
+	registrationRepo repositories.RegistrationRepository
+}
+
+func NewAttendanceService(
+	eventRepo repositories.EventRepository,
+	sessionRepo repositories.AttendanceSessionRepository,
+	attendanceRepo repositories.SessionAttendanceRepository,
+	checkInRepo repositories.CheckInRepository,
+	teamRepo repositories.TeamRepository,
+	registrationRepo repositories.RegistrationRepository,
+) AttendanceService {
+	return &attendanceService{
+		eventRepo:        eventRepo,
+		sessionRepo:      sessionRepo,
+		attendanceRepo:   attendanceRepo,
+		checkInRepo:      checkInRepo,
+		teamRepo:         teamRepo,
+		registrationRepo: registrationRepo,
+	}
+}
+
+type CreateSessionRequest struct {
+	Name             string                       `json:"name" binding:"required"`
+	Description      string                       `json:"description"`
+	Kind             string                       `json:"kind"`
+	StartTime        time.Time                    `json:"start_time" binding:"required"`
+	EndTime          time.Time                    `json:"end_time" binding:"required"`
+	Requirement      models.AttendanceRequirement `json:"requirement"`
+	OrganizerAddress string                       `json:"organizer_address" binding:"required"`
+}
+
+type UpdateSessionRequest struct {
+	Name             *string                       `json:"name"`
+	Description      *string                       `json:"description"`
+	Kind             *string                       `json:"kind"`
+	StartTime        *time.Time                    `json:"start_time"`
+	EndTime          *time.Time                    `json:"end_time"`
+	Requirement      *models.AttendanceRequirement `json:"requirement"`
+	OrganizerAddress string                        `json:"organizer_address" binding:"required"`
+}
+
+// SessionAttendanceRequest is a participant-signed session check-in or check-out.
+// The signed message must start with "Check-in for session <id>\n" or
+// "Check-out for session <id>\n" respectively.
+type SessionAttendanceRequest struct {
+	UserAddress string `json:"user_address" binding:"required"`
+	Signature   string `json:"signature" binding:"required"`
+	Message     string `json:"message" binding:"required"`
+	TeamID      *uint  `json:"team_id"`
+}
+
+// SessionPresence is one participant's presence in one session.
+type SessionPresence struct {
+	SessionID       uint       `json:"session_id"`
+	Present         bool       `json:"present"`
+	CheckInTime     *time.Time `json:"check_in_time,omitempty"`
+	CheckOutTime    *time.Time `json:"check_out_time,omitempty"`
+	DurationMinutes float64    `json:"duration_minutes"`
+}
+
+// ParticipantAttendance aggregates presence across all sessions of an event.
+type ParticipantAttendance struct {
+	UserAddress      string            `json:"user_address"`
+	TeamID           *uint             `json:"team_id"`
+	EventCheckedIn   bool              `json:"event_checked_in"`
+	SessionsAttended int               `json:"sessions_attended"`
+	Sessions         []SessionPresence `json:"sessions"`
+}
+
+// TeamEligibility reports whether a team satisfies the attendance rules.
+type TeamEligibility struct {
+	TeamID   uint     `json:"team_id"`
+	TeamName string   `json:"team_name"`
+	Eligible bool     `json:"eligible"`
+	Issues   []string `json:"issues,omitempty"`
+}
+
+type AttendanceReport struct {
+	EventID      uint                       `json:"event_id"`
+	Sessions     []models.AttendanceSession `json:"sessions"`
+	Participants []ParticipantAttendance    `json:"participants"`
+	Teams        []TeamEligibility          `json:"teams"`
+}
+
+func validAttendanceRequirement(req models.AttendanceRequirement) bool {
+	switch req {
+	case models.AttendanceRequirementNone, models.AttendanceRequirementAnyMember, models.AttendanceRequirementAllMembers:
+		return true
+	}
+	return false
+}
+
+func (s *attendanceService) requireOrganizer(eventID uint, organizerAddress string) (*models.Event, error) {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+	if normalizeAddress(event.OrganizerAddress) != normalizeAddress(organizerAddress) {
+		return nil, errors.New("only the organizer can manage attendance sessions")
+	}
+	return event, nil
+}
+
+func (s *attendanceService) CreateSession(eventID uint, req *CreateSessionRequest) (*models.AttendanceSession, error) {
+	if _, err := s.requireOrganizer(eventID, req.OrganizerAddress); err != nil {
+		return nil, err
+	}
+
+	if !req.EndTime.After(req.StartTime) {
+		return nil, errors.New("end time must be after start time")
+	}
+
+	requirement := req.Requirement
+	if requirement == "" {
+		requirement = models.AttendanceRequirementNone
+	}
+	if !validAttendanceRequirement(requirement) {
+		return nil, errors.New("invalid attendance requirement")
+	}
+
+	session := &models.AttendanceSession{
+		EventID:     eventID,
+		Name:        req.Name,
+		Description: req.Description,
+		Kind:        req.Kind,
+		StartTime:   req.StartTime,
+		EndTime:     req.EndTime,
+		Requirement: requirement,
+	}
+	if err := s.sessionRepo.Create(session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+func (s *attendanceService) ListSessions(eventID uint) ([]models.AttendanceSession, error) {
+	return s.sessionRepo.ListByEvent(eventID)
+}
+
+func (s *attendanceService) UpdateSession(sessionID uint, req *UpdateSessionRequest) (*models.AttendanceSession, error) {
+	session, err := s.sessionRepo.GetByID(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.requireOrganizer(session.EventID, req.OrganizerAddress); err != nil {
+		return nil, err
+	}
+
+	if req.Name != nil {
+		session.Name = *req.Name
+	}
+	if req.Description != nil {
+		session.Description = *req.Description
+	}
+	if req.Kind != nil {
+		session.Kind = *req.Kind
+	}
+	if req.StartTime != nil {
+		session.StartTime = *req.StartTime
+	}
+	if req.EndTime != nil {
+		session.EndTime = *req.EndTime
+	}
+	if req.Requirement != nil {
+		if !validAttendanceRequirement(*req.Requirement) {
+			return nil, errors.New("invalid attendance requirement")
+		}
+		session.Requirement = *req.Requirement
+	}
+
+	if !session.EndTime.After(session.StartTime) {
+		return nil, errors.New("end time must be after start time")
+	}
+
+	if err := s.sessionRepo.Update(session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+func (s *attendanceService) DeleteSession(sessionID uint, organizerAddress string) error {
+	session, err := s.sessionRepo.GetByID(sessionID)
+	if err != nil {
+		return err
+	}
+	if _, err := s.requireOrganizer(session.EventID, organizerAddress); err != nil {
+		return err
+	}
+	return s.sessionRepo.Delete(sessionID)
+}
+
+func (s *attendanceService) CheckIn(sessionID uint, req *SessionAttendanceRequest) (*models.SessionAttendance, error) {
+	session, err := s.sessionRepo.GetByID(sessionID)
+	if err != nil {
+		return nil, errors.New("session not found")
+	}
+
+	now := time.Now()
+	if now.Before(session.StartTime.Add(-sessionEarlyCheckIn)) {
+		return nil, errors.New("session check-in has not opened yet")
+	}
+	if now.After(session.EndTime) {
+		return nil, errors.New("session has already ended")
+	}
+
+	address := normalizeAddress(req.UserAddress)
+	if existing, _ := s.attendanceRepo.GetBySessionAndUser(sessionID, address); existing != nil {
+		return nil, errors.New("user already checked in to this session")
+	}
+
+	if !strings.HasPrefix(req.Message, fmt.Sprintf("Check-in for session %d\n", sessionID)) {
+		return nil, errors.New("signed message does not reference this session")
+	}
+	if err := verifyPersonalSignature(req.UserAddress, req.Message, req.Signature); err != nil {
+		return nil, fmt.Errorf("signature verification failed: %v", err)
+	}
+
+	if req.TeamID != nil {
+		team, err := s.teamRepo.GetByID(*req.TeamID)
+		if err != nil {
+			return nil, errors.New("team not found")
+		}
+		if !isTeamMember(team, address) {
+			return nil, errors.New("user is not a member of the specified team")
+		}
+	}
+
+	attendance := &models.SessionAttendance{
+		SessionID:        sessionID,
+		EventID:          session.EventID,
+		UserAddress:      address,
+		TeamID:           req.TeamID,
+		CheckInTime:      now,
+		CheckInSignature: req.Signature,
+		CheckInMessage:   req.Message,
+	}
+	if err := s.attendanceRepo.Create(attendance); err != nil {
+		return nil, err
+	}
+	return attendance, nil
+}
+
+func (s *attendanceService) CheckOut(sessionID uint, req *SessionAttendanceRequest) (*models.SessionAttendance, error) {
+	address := normalizeAddress(req.UserAddress)
+	attendance, err := s.attendanceRepo.GetBySessionAndUser(sessionID, address)
+	if err != nil {
+		return nil, errors.New("user has not checked in to this session")
+	}
+	if attendance.CheckOutTime != nil {
+		return nil, errors.New("user already checked out of this session")
+	}
+
+	if !strings.HasPrefix(req.Message, fmt.Sprintf("Check-out for session %d\n", sessionID)) {
+		return nil, errors.New("signed message does not reference this session")
+	}
+	if err := verifyPersonalSignature(req.UserAddress, req.Message, req.Signature); err != nil {
+		return nil, fmt.Errorf("signature verification failed: %v", err)
+	}
+
+	now := time.Now()
+	attendance.CheckOutTime = &now
+	attendance.CheckOutSignature = req.Signature
+	attendance.CheckOutMessage = req.Message
+	if err := s.attendanceRepo.Update(attendance); err != nil {
+		return nil, err
+	}
+	return attendance, nil
+}
+
+func (s *attendanceService) ListAttendees(sessionID uint) ([]models.SessionAttendance, error) {
+	return s.attendanceRepo.ListBySession(sessionID)
+}
+
+// sessionAttendees indexes attendance records by session and normalized address.
+func sessionAttendees(records []models.SessionAttendance) map[uint]map[string]*models.SessionAttendance {
+	index := make(map[uint]map[string]*models.SessionAttendance)
+	for i := range records {
+		record := &records[i]
+		if index[record.SessionID] == nil {
+			index[record.SessionID] = make(map[string]*models.SessionAttendance)
+		}
+		index[record.SessionID][normalizeAddress(record.UserAddress)] = record
+	}
+	return index
+}
+
+// teamAddresses returns the normalized, de-duplicated leader and member addresses.
+func teamAddresses(team *models.Team) []string {
+	seen := make(map[string]bool)
+	var addresses []string
+	for _, address := range append([]string{team.LeaderAddress}, memberAddresses(team)...) {
+		address = normalizeAddress(address)
+		if address == "" || seen[address] {
+			continue
+		}
+		seen[address] = true
+		addresses = append(addresses, address)
+	}
+	return addresses
+}
+
+func memberAddresses(team *models.Team) []string {
+	addresses := make([]string, 0, len(team.Members))
+	for _, member := range team.Members {
+		addresses = append(addresses, member.Address)
+	}
+	return addresses
+}
+
+func evaluateTeamEligibility(team *models.Team, sessions []models.AttendanceSession, attendees map[uint]map[string]*models.SessionAttendance) TeamEligibility {
+	result := TeamEligibility{TeamID: team.ID, TeamName: team.Name, Eligible: true}
+	addresses := teamAddresses(team)
+
+	for _, session := range sessions {
+		present := 0
+		for _, address := range addresses {
+			if attendees[session.ID][address] != nil {
+				present++
+			}
+		}
+
+		switch session.Requirement {
+		case models.AttendanceRequirementAnyMember:
+			if present == 0 {
+				result.Issues = append(result.Issues, fmt.Sprintf("no team member attended %q", session.Name))
+			}
+		case models.AttendanceRequirementAllMembers:
+			if missing := len(addresses) - present; missing > 0 {
+				result.Issues = append(result.Issues, fmt.Sprintf("%d team member(s) missed %q", missing, session.Name))
+			}
+		}
+	}
+
+	result.Eligible = len(result.Issues) == 0
+	return result
+}
+
+func (s *attendanceService) GetTeamEligibility(eventID uint, teamID uint) (*TeamEligibility, error) {
+	registration, err := s.registrationRepo.GetByEventAndTeam(eventID, teamID)
+	if err != nil || registration.Team.ID == 0 || registration.Status == models.RegistrationStatusRejected {
+		return nil, errors.New("team is not registered for this event")
+	}
+	sessions, err := s.sessionRepo.ListByEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+	records, err := s.attendanceRepo.ListByEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := evaluateTeamEligibility(&registration.Team, sessions, sessionAttendees(records))
+	return &result, nil
+}
+
+// GetEventEligibility evaluates every team registered for the event, keyed
+// by team ID, loading sessions and attendance once. Rejected registrations
+// and teams that have since been deleted are left out.
+func (s *attendanceService) GetEventEligibility(eventID uint) (map[uint]TeamEligibility, error) {
+	sessions, err := s.sessionRepo.ListByEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+	records, err := s.attendanceRepo.ListByEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+	registrations, err := s.registrationRepo.GetByEventID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	attendees := sessionAttendees(records)
+
+	eligibility := make(map[uint]TeamEligibility, len(registrations))
+	for i := range registrations {
+		if registrations[i].Status == models.RegistrationStatusRejected {
+			continue
+		}
+		team := &registrations[i].Team
+		if team.ID == 0 {
+			continue
+		}
+		eligibility[team.ID] = evaluateTeamEligibility(team, sessions, attendees)
+	}
+	return eligibility, nil
+}
+
+func (s *attendanceService) GetAttendanceReport(eventID uint) (*AttendanceReport, error) {
+	if _, err := s.eventRepo.GetByID(eventID); err != nil {
+		return nil, errors.New("event not found")
+	}
+
+	sessions, err := s.sessionRepo.ListByEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+	records, err := s.attendanceRepo.ListByEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+	checkIns, err := s.checkInRepo.GetByEventID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	registrations, err := s.registrationRepo.GetByEventID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	attendees := sessionAttendees(records)
+
+	report := &AttendanceReport{
+		EventID:      eventID,
+		Sessions:     sessions,
+		Participants: []ParticipantAttendance{},
+		Teams:        []TeamEligibility{},
+	}
+
+	participants := make(map[string]*ParticipantAttendance)
+	participant := func(address string) *ParticipantAttendance {
+		address = normalizeAddress(address)
+		if participants[address] == nil {
+			participants[address] = &ParticipantAttendance{UserAddress: address}
+		}
+		return participants[address]
+	}
+
+	for i := range registrations {
+		registration := &registrations[i]
+		if registration.Status == models.RegistrationStatusRejected {
+			continue
+		}
+		teamID := registration.TeamID
+		for _, address := range teamAddresses(&registration.Team) {
+			participant(address).TeamID = &teamID
+		}
+		report.Teams = append(report.Teams, evaluateTeamEligibility(&registration.Team, sessions, attendees))
+	}
+	for _, checkIn := range checkIns {
+		p := participant(checkIn.UserAddress)
+		p.EventCheckedIn = true
+		if p.TeamID == nil {
+			p.TeamID = checkIn.TeamID
+		}
+	}
+	for _, record := range records {
+		p := participant(record.UserAddress)
+		if p.TeamID == nil {
+			p.TeamID = record.TeamID
+		}
+	}
+
+	for _, p := range participants {
+		p.Sessions = make([]SessionPresence, 0, len(sessions))
+		for _, session := range sessions {
+			presence := SessionPresence{SessionID: session.ID}
+			if record := attendees[session.ID][p.UserAddress]; record != nil {
+				checkInTime := record.CheckInTime
+				presence.Present = true
+				presence.CheckInTime = &checkInTime
+				presence.CheckOutTime = record.CheckOutTime
+				// Without a check-out, presence counts until the session ends
+				end := session.EndTime
+				if record.CheckOutTime != nil {
+					end = *record.CheckOutTime
+				}
+				if end.After(checkInTime) {
+					presence.DurationMinutes = end.Sub(checkInTime).Minutes()
+				}
+				p.SessionsAttended++
+			}
+			p.Sessions = append(p.Sessions, presence)
+		}
+		report.Participants = append(report.Participants, *p)
+	}
+	sort.Slice(report.Participants, func(i, j int) bool {
+		return report.Participants[i].UserAddress < report.Participants[j].UserAddress
+	})
+
+	return report, nil
+}
@@ -0,0 +1,129 @@
+package services
+
+import (
+	"fmt"
+	"hackathon-platform/backend/models"
+	"hackathon-platform/backend/repositories"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type stubSessionRepo struct {
+	repositories.AttendanceSessionRepository
+	sessions []models.AttendanceSession
+}
+
+func (r *stubSessionRepo) GetByID(id uint) (*models.AttendanceSession, error) {
+	for i := range r.sessions {
+		if r.sessions[i].ID == id {
+			return &r.sessions[i], nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *stubSessionRepo) ListByEvent(eventID uint) ([]models.AttendanceSession, error) {
+	return r.sessions, nil
+}
+
+type memorySessionAttendanceRepo struct {
+	repositories.SessionAttendanceRepository
+	records []*models.SessionAttendance
+}
+
+func (r *memorySessionAttendanceRepo) Create(attendance *models.SessionAttendance) error {
+	attendance.ID = uint(len(r.records) + 1)
+	r.records = append(r.records, attendance)
+	return nil
+}
+
+func (r *memorySessionAttendanceRepo) GetBySessionAndUser(sessionID uint, userAddress string) (*models.SessionAttendance, error) {
+	for _, record := range r.records {
+		if record.SessionID == sessionID && record.UserAddress == userAddress {
+			return record, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *memorySessionAttendanceRepo) ListByEvent(eventID uint) ([]models.SessionAttendance, error) {
+	records := make([]models.SessionAttendance, 0, len(r.records))
+	for _, record := range r.records {
+		records = append(records, *record)
+	}
+	return records, nil
+}
+
+func (r *memorySessionAttendanceRepo) Update(attendance *models.SessionAttendance) error {
+	return nil
+}
+
+type stubRegistrationRepo struct {
+	repositories.RegistrationRepository
+	registrations []models.Registration
+}
+
+func (r *stubRegistrationRepo) GetByEventID(eventID uint) ([]models.Registration, error) {
+	return r.registrations, nil
+}
+
+func TestSessionCheckInAndOutWithSignatures(t *testing.T) {
+	participant := newTestWallet(t)
+	now := time.Now()
+	session := models.AttendanceSession{ID: 9, EventID: 1, Name: "Day 1", StartTime: now.Add(-time.Hour), EndTime: now.Add(time.Hour)}
+	records := &memorySessionAttendanceRepo{}
+	service := NewAttendanceService(nil, &stubSessionRepo{sessions: []models.AttendanceSession{session}}, records, nil, nil, nil)
+
+	checkInMessage := fmt.Sprintf("Check-in for session %d\nTimestamp: %d", session.ID, now.Unix())
+	attendance, err := service.CheckIn(session.ID, &SessionAttendanceRequest{
+		UserAddress: participant.address,
+		Message:     checkInMessage,
+		Signature:   participant.sign(t, checkInMessage),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkOutMessage := fmt.Sprintf("Check-out for session %d\nTimestamp: %d", session.ID, now.Unix())
+	if _, err := service.CheckOut(session.ID, &SessionAttendanceRequest{
+		UserAddress: participant.address,
+		Message:     checkOutMessage,
+		Signature:   newTestWallet(t).sign(t, checkOutMessage),
+	}); err == nil {
+		t.Fatal("check-out signed by another key was accepted")
+	}
+	if _, err := service.CheckOut(session.ID, &SessionAttendanceRequest{
+		UserAddress: participant.address,
+		Message:     checkOutMessage,
+		Signature:   participant.sign(t, checkOutMessage),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if attendance.CheckOutTime == nil || attendance.CheckOutMessage != checkOutMessage {
+		t.Fatalf("check-out was not recorded: %+v", attendance)
+	}
+}
+
+func TestEventEligibilitySkipsRejectedRegistrations(t *testing.T) {
+	session := models.AttendanceSession{ID: 9, EventID: 1, Name: "Demo", Requirement: models.AttendanceRequirementAnyMember}
+	attended := &models.Team{ID: 1, Name: "Present", LeaderAddress: testOrganizer}
+	rejected := &models.Team{ID: 2, Name: "Rejected", LeaderAddress: testOrganizer}
+	records := &memorySessionAttendanceRepo{records: []*models.SessionAttendance{
+		{SessionID: session.ID, EventID: 1, UserAddress: testOrganizer},
+	}}
+	registrations := &stubRegistrationRepo{registrations: []models.Registration{
+		{EventID: 1, TeamID: attended.ID, Team: *attended, Status: models.RegistrationStatusApproved},
+		{EventID: 1, TeamID: rejected.ID, Team: *rejected, Status: models.RegistrationStatusRejected},
+	}}
+	service := NewAttendanceService(nil, &stubSessionRepo{sessions: []models.AttendanceSession{session}}, records, nil, nil, registrations)
+
+	eligibility, err := service.GetEventEligibility(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(eligibility) != 1 || !eligibility[attended.ID].Eligible {
+		t.Fatalf("eligibility = %+v, want only the approved team, eligible", eligibility)
+	}
+}
@@ -0,0 +1,255 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"hackathon-platform/backend/models"
+	"hackathon-platform/backend/repositories"
+	"time"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"gorm.io/gorm"
+)
+
+const defaultBallotMaxRanks = 5
+
+// BallotService handles ranked ballots and their tallies.
+type BallotService interface {
+	CastBallot(req *CastBallotRequest) (*models.Ballot, error)
+	PrepareBallot(req *CastBallotRequest) (*SignedTypedData, error)
+	GetBallot(eventID uint, voterAddress string) (*models.Ballot, error)
+	GetResults(eventID uint, method models.BallotMethod, viewer *ViewerProof) (*BallotResults, error)
+}
+
+type ballotService struct {
+	ballotRepo     repositories.BallotRepository
+	eventRepo      repositories.EventRepository
+	submissionRepo repositories.SubmissionRepository
+	conflictRepo   repositories.JudgeConflictRepository
+	nonceRepo      repositories.VoteNonceRepository
+	publicVoters   PublicVoterService
+	live           LiveNotifier
+}
+
+func NewBallotService(
+	ballotRepo repositories.BallotRepository,
+	eventRepo repositories.EventRepository,
+	submissionRepo repositories.SubmissionRepository,
+	conflictRepo repositories.JudgeConflictRepository,
+	nonceRepo repositories.VoteNonceRepository,
+	publicVoters PublicVoterService,
+	live LiveNotifier,
+) BallotService {
+	return &ballotService{
+		ballotRepo:     ballotRepo,
+		eventRepo:      eventRepo,
+		submissionRepo: submissionRepo,
+		conflictRepo:   conflictRepo,
+		nonceRepo:      nonceRepo,
+		publicVoters:   publicVoters,
+		live:           live,
+	}
+}
+
+// CastBallotRequest ranks submissions, favourite first. Casting again
+// while voting is open replaces the voter's ballot.
+type CastBallotRequest struct {
+	EventID       uint   `json:"event_id" binding:"required"`
+	VoterAddress  string `json:"voter_address" binding:"required"`
+	Rankings      []uint `json:"rankings" binding:"required"` // Submission IDs in order of preference
+	OffchainProof string `json:"offchain_proof"`              // Personhood proof when the event requires one of public voters
+	Signature     string `json:"signature"`                   // EIP-712 signature over the ballot typed data
+	Nonce         uint64 `json:"nonce"`
+	ChainID       int64  `json:"chain_id"`
+}
+
+func (s *ballotService) CastBallot(req *CastBallotRequest) (*models.Ballot, error) {
+	event, address, rankings, err := s.checkBallot(req)
+	if err != nil {
+		return nil, err
+	}
+
+	typedData := ballotTypedData(req.ChainID, event.ID, address, rankings, req.Nonce)
+	payload, err := consumeSignedTypedData(s.nonceRepo, "ballot", typedData, event.ID, address, req.Signature, req.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	ballot, err := s.ballotRepo.GetByEventAndVoter(event.ID, address)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		ballot = &models.Ballot{EventID: event.ID, VoterAddress: address}
+	} else if err != nil {
+		return nil, err
+	}
+	ballot.Rankings = rankings
+	ballot.Signature = req.Signature
+	ballot.SignedPayload = payload
+	ballot.Nonce = req.Nonce
+	if err := s.ballotRepo.Save(ballot); err != nil {
+		return nil, err
+	}
+
+	notifyLive(s.live, event.ID, LiveTopicVotes)
+	return ballot, nil
+}
+
+// PrepareBallot runs the same checks as CastBallot and returns the typed
+// data the voter must sign. Nothing is recorded.
+func (s *ballotService) PrepareBallot(req *CastBallotRequest) (*SignedTypedData, error) {
+	event, address, rankings, err := s.checkBallot(req)
+	if err != nil {
+		return nil, err
+	}
+	return prepareSignedTypedData(s.nonceRepo, event.ID, address, req.ChainID, func(nonce uint64) apitypes.TypedData {
+		return ballotTypedData(req.ChainID, event.ID, address, rankings, nonce)
+	})
+}
+
+// checkBallot validates the voter and rankings of a ballot.
+func (s *ballotService) checkBallot(req *CastBallotRequest) (*models.Event, string, []models.BallotRanking, error) {
+	address := normalizeAddress(req.VoterAddress)
+	if address == "" {
+		return nil, "", nil, errors.New("invalid voter address")
+	}
+
+	event, err := s.eventRepo.GetByID(req.EventID)
+	if err != nil {
+		return nil, "", nil, errors.New("event not found")
+	}
+	if event.BallotMethod == "" {
+		return nil, "", nil, errors.New("ranked ballots are not enabled for this event")
+	}
+	if err := checkVotingOpen(event, time.Now()); err != nil {
+		return nil, "", nil, err
+	}
+	if err := s.publicVoters.CheckVoter(event, address, req.OffchainProof); err != nil {
+		return nil, "", nil, err
+	}
+
+	maxRanks := ballotMaxRanks(event)
+	if len(req.Rankings) == 0 {
+		return nil, "", nil, errors.New("ballot must rank at least one submission")
+	}
+	if len(req.Rankings) > maxRanks {
+		return nil, "", nil, fmt.Errorf("ballot can rank at most %d submissions", maxRanks)
+	}
+
+	rankings := make([]models.BallotRanking, 0, len(req.Rankings))
+	seen := make(map[uint]bool, len(req.Rankings))
+	for i, submissionID := range req.Rankings {
+		if seen[submissionID] {
+			return nil, "", nil, fmt.Errorf("submission %d is ranked more than once", submissionID)
+		}
+		seen[submissionID] = true
+		if err := s.checkRankable(event.ID, submissionID, address); err != nil {
+			return nil, "", nil, err
+		}
+		rankings = append(rankings, models.BallotRanking{SubmissionID: submissionID, Rank: i + 1})
+	}
+
+	return event, address, rankings, nil
+}
+
+func (s *ballotService) GetBallot(eventID uint, voterAddress string) (*models.Ballot, error) {
+	return s.ballotRepo.GetByEventAndVoter(eventID, normalizeAddress(voterAddress))
+}
+
+// GetResults tallies the event's ballots with its configured method, or
+// with method when given so organizers can compare methods.
+func (s *ballotService) GetResults(eventID uint, method models.BallotMethod, viewer *ViewerProof) (*BallotResults, error) {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	if talliesHidden(event) && !isOrganizerViewer(event, viewer) {
+		return nil, ErrTalliesHidden
+	}
+	if method == "" {
+		method = event.BallotMethod
+	}
+	if method == "" {
+		return nil, errors.New("ranked ballots are not enabled for this event")
+	}
+	if !validBallotMethod(method) {
+		return nil, errors.New("unsupported ballot method")
+	}
+
+	submissions, err := s.submissionRepo.GetByEventID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	eligible := make(map[uint]bool, len(submissions))
+	for _, submission := range submissions {
+		if submissionFinalized(submission.Status) {
+			eligible[submission.ID] = true
+		}
+	}
+
+	ballots, err := s.ballotRepo.ListByEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+	orders := make([][]uint, 0, len(ballots))
+	for _, ballot := range ballots {
+		// Submissions withdrawn since the ballot was cast are skipped
+		var order []uint
+		for _, ranking := range ballot.Rankings {
+			if eligible[ranking.SubmissionID] {
+				order = append(order, ranking.SubmissionID)
+			}
+		}
+		orders = append(orders, order)
+	}
+
+	results := tallyBallots(method, sortedIDs(eligible), orders, ballotMaxRanks(event))
+	results.EventID = eventID
+	return results, nil
+}
+
+// checkRankable ensures a submission can appear on the voter's ballot.
+func (s *ballotService) checkRankable(eventID uint, submissionID uint, address string) error {
+	submission, err := s.submissionRepo.GetByID(submissionID)
+	if err != nil {
+		return fmt.Errorf("submission %d not found", submissionID)
+	}
+	if submission.EventID != eventID {
+		return fmt.Errorf("submission %d does not belong to this event", submissionID)
+	}
+	if !submissionFinalized(submission.Status) {
+		return fmt.Errorf("submission %d has not been finalized by the team", submissionID)
+	}
+	declared, err := s.conflictRepo.Exists(eventID, address, submission.TeamID)
+	if err != nil {
+		return err
+	}
+	if voteConflict(&submission.Team, address, declared) != "" {
+		return fmt.Errorf("you cannot rank submission %d because of a conflict of interest", submissionID)
+	}
+	return nil
+}
+
+// validateBallots checks an event's ranked ballot settings.
+func validateBallots(event *models.Event) error {
+	if event.BallotMethod != "" && !validBallotMethod(event.BallotMethod) {
+		return errors.New("unsupported ballot method")
+	}
+	if event.BallotMaxRanks < 0 {
+		return errors.New("ballot max ranks cannot be negative")
+	}
+	return nil
+}
+
+func validBallotMethod(method models.BallotMethod) bool {
+	switch method {
+	case models.BallotBorda, models.BallotIRV, models.BallotSchulze:
+		return true
+	}
+	return false
+}
+
+func ballotMaxRanks(event *models.Event) int {
+	if event.BallotMaxRanks > 0 {
+		return event.BallotMaxRanks
+	}
+	return defaultBallotMaxRanks
+}
@@ -0,0 +1,299 @@
+package services
+
+import (
+	"hackathon-platform/backend/models"
+	"sort"
+)
+
+// BallotResults is the outcome of tallying an event's ranked ballots.
+type BallotResults struct {
+	EventID   uint                `json:"event_id"`
+	Method    models.BallotMethod `json:"method"`
+	Ballots   int                 `json:"ballots"`
+	Winner    *uint               `json:"winner"`
+	Standings []BallotStanding    `json:"standings"`
+	Rounds    []BallotRound       `json:"rounds,omitempty"`   // Borda and instant-runoff
+	Pairwise  []PairwiseResult    `json:"pairwise,omitempty"` // Schulze
+}
+
+// BallotStanding is a submission's final position. Score is its Borda
+// points, its votes in the last instant-runoff round it took part in, or its
+// number of Schulze pairwise wins.
+type BallotStanding struct {
+	SubmissionID uint    `json:"submission_id"`
+	Position     int     `json:"position"`
+	Score        float64 `json:"score"`
+}
+
+// BallotRound is one counting round.
+type BallotRound struct {
+	Round      int          `json:"round"`
+	Tallies    []RoundTally `json:"tallies"`
+	Exhausted  int          `json:"exhausted"` // Ballots with no continuing submission left
+	Eliminated []uint       `json:"eliminated,omitempty"`
+	Elected    *uint        `json:"elected,omitempty"`
+}
+
+// RoundTally is a submission's count in one round.
+type RoundTally struct {
+	SubmissionID uint    `json:"submission_id"`
+	Votes        float64 `json:"votes"`
+}
+
+// PairwiseResult compares two submissions head to head. Strength is the
+// strongest path from A to B that Schulze ranks by.
+type PairwiseResult struct {
+	A        uint `json:"a"`
+	B        uint `json:"b"`
+	PreferA  int  `json:"prefer_a"`
+	PreferB  int  `json:"prefer_b"`
+	Strength int  `json:"strength"`
+}
+
+// tallyBallots counts ballots, each an ordered list of submission IDs with
+// the favourite first, over the candidate submissions.
+func tallyBallots(method models.BallotMethod, candidates []uint, ballots [][]uint, maxRanks int) *BallotResults {
+	results := &BallotResults{Method: method, Ballots: len(ballots), Standings: []BallotStanding{}}
+	if len(candidates) == 0 {
+		return results
+	}
+	switch method {
+	case models.BallotIRV:
+		results.Standings, results.Rounds = tallyIRV(candidates, ballots)
+	case models.BallotSchulze:
+		results.Standings, results.Pairwise = tallySchulze(candidates, ballots)
+	default:
+		results.Standings, results.Rounds = tallyBorda(candidates, ballots, maxRanks)
+	}
+	// A tie for first place leaves the winner to the organizer
+	tied := len(results.Standings) > 1 && results.Standings[1].Position == 1
+	if len(results.Standings) > 0 && results.Ballots > 0 && !tied {
+		winner := results.Standings[0].SubmissionID
+		results.Winner = &winner
+	}
+	return results
+}
+
+// tallyBorda awards maxRanks points for a first place, one less for each
+// place below it, and nothing to unranked submissions.
+func tallyBorda(candidates []uint, ballots [][]uint, maxRanks int) ([]BallotStanding, []BallotRound) {
+	points := make(map[uint]float64, len(candidates))
+	for _, ballot := range ballots {
+		for i, submissionID := range ballot {
+			if i >= maxRanks {
+				break
+			}
+			points[submissionID] += float64(maxRanks - i)
+		}
+	}
+	standings := rankStandings(candidates, points)
+	round := BallotRound{Round: 1}
+	for _, standing := range standings {
+		round.Tallies = append(round.Tallies, RoundTally{SubmissionID: standing.SubmissionID, Votes: standing.Score})
+	}
+	return standings, []BallotRound{round}
+}
+
+// tallyIRV counts each ballot for its highest continuing submission and
+// eliminates the weakest until one has a majority of the ballots still in
+// play. Ties for last place are broken by the earlier rounds' counts, then
+// by eliminating the highest submission ID.
+func tallyIRV(candidates []uint, ballots [][]uint) ([]BallotStanding, []BallotRound) {
+	continuing := make(map[uint]bool, len(candidates))
+	for _, id := range candidates {
+		continuing[id] = true
+	}
+
+	var rounds []BallotRound
+	var history []map[uint]float64
+	var eliminated []BallotStanding // In elimination order
+	for round := 1; len(continuing) > 0; round++ {
+		counts := make(map[uint]float64, len(continuing))
+		for id := range continuing {
+			counts[id] = 0
+		}
+		exhausted := 0
+		for _, ballot := range ballots {
+			counted := false
+			for _, id := range ballot {
+				if continuing[id] {
+					counts[id]++
+					counted = true
+					break
+				}
+			}
+			if !counted {
+				exhausted++
+			}
+		}
+		history = append(history, counts)
+
+		ids := sortedIDs(continuing)
+		current := BallotRound{Round: round, Exhausted: exhausted}
+		active := 0.0
+		for _, id := range ids {
+			current.Tallies = append(current.Tallies, RoundTally{SubmissionID: id, Votes: counts[id]})
+			active += counts[id]
+		}
+		sort.SliceStable(current.Tallies, func(i, j int) bool {
+			return current.Tallies[i].Votes > current.Tallies[j].Votes
+		})
+
+		leader := current.Tallies[0]
+		if leader.Votes*2 > active || len(ids) == 1 {
+			elected := leader.SubmissionID
+			current.Elected = &elected
+			rounds = append(rounds, current)
+
+			standings := make([]BallotStanding, 0, len(candidates))
+			for _, tally := range current.Tallies {
+				standings = append(standings, BallotStanding{SubmissionID: tally.SubmissionID, Score: tally.Votes})
+			}
+			for i := len(eliminated) - 1; i >= 0; i-- {
+				standings = append(standings, eliminated[i])
+			}
+			for i := range standings {
+				standings[i].Position = i + 1
+			}
+			return standings, rounds
+		}
+
+		loser := ids[0]
+		for _, id := range ids[1:] {
+			if irvWeaker(id, loser, history) {
+				loser = id
+			}
+		}
+		current.Eliminated = []uint{loser}
+		rounds = append(rounds, current)
+		eliminated = append(eliminated, BallotStanding{SubmissionID: loser, Score: counts[loser]})
+		delete(continuing, loser)
+	}
+	return []BallotStanding{}, rounds
+}
+
+// irvWeaker reports whether a should be eliminated before b, comparing the
+// latest round first and falling back to earlier rounds.
+func irvWeaker(a, b uint, history []map[uint]float64) bool {
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i][a] != history[i][b] {
+			return history[i][a] < history[i][b]
+		}
+	}
+	return a > b
+}
+
+// tallySchulze ranks submissions by the number of others they beat on the
+// strongest path. A ranked submission is preferred over an unranked one.
+func tallySchulze(candidates []uint, ballots [][]uint) ([]BallotStanding, []PairwiseResult) {
+	n := len(candidates)
+	index := make(map[uint]int, n)
+	for i, id := range candidates {
+		index[id] = i
+	}
+
+	prefer := make([][]int, n)
+	strength := make([][]int, n)
+	for i := range prefer {
+		prefer[i] = make([]int, n)
+		strength[i] = make([]int, n)
+	}
+	for _, ballot := range ballots {
+		position := make(map[int]int, len(ballot))
+		for rank, id := range ballot {
+			if i, ok := index[id]; ok {
+				position[i] = rank
+			}
+		}
+		for i := 0; i < n; i++ {
+			pi, rankedI := position[i]
+			if !rankedI {
+				continue
+			}
+			for j := 0; j < n; j++ {
+				if i == j {
+					continue
+				}
+				if pj, rankedJ := position[j]; !rankedJ || pi < pj {
+					prefer[i][j]++
+				}
+			}
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if i != j && prefer[i][j] > prefer[j][i] {
+				strength[i][j] = prefer[i][j]
+			}
+		}
+	}
+	for k := 0; k < n; k++ {
+		for i := 0; i < n; i++ {
+			if i == k {
+				continue
+			}
+			for j := 0; j < n; j++ {
+				if j == i || j == k {
+					continue
+				}
+				strength[i][j] = max(strength[i][j], min(strength[i][k], strength[k][j]))
+			}
+		}
+	}
+
+	wins := make(map[uint]float64, n)
+	var pairwise []PairwiseResult
+	for i := 0; i < n; i++ {
+		wins[candidates[i]] = 0
+		for j := 0; j < n; j++ {
+			if i == j {
+				continue
+			}
+			if strength[i][j] > strength[j][i] {
+				wins[candidates[i]]++
+			}
+			if i < j {
+				pairwise = append(pairwise, PairwiseResult{
+					A:        candidates[i],
+					B:        candidates[j],
+					PreferA:  prefer[i][j],
+					PreferB:  prefer[j][i],
+					Strength: strength[i][j] - strength[j][i],
+				})
+			}
+		}
+	}
+	return rankStandings(candidates, wins), pairwise
+}
+
+// rankStandings orders candidates by score, highest first, with ties
+// sharing a position and listed by submission ID.
+func rankStandings(candidates []uint, scores map[uint]float64) []BallotStanding {
+	standings := make([]BallotStanding, 0, len(candidates))
+	for _, id := range candidates {
+		standings = append(standings, BallotStanding{SubmissionID: id, Score: scores[id]})
+	}
+	sort.SliceStable(standings, func(i, j int) bool {
+		if standings[i].Score != standings[j].Score {
+			return standings[i].Score > standings[j].Score
+		}
+		return standings[i].SubmissionID < standings[j].SubmissionID
+	})
+	for i := range standings {
+		standings[i].Position = i + 1
+		if i > 0 && standings[i].Score == standings[i-1].Score {
+			standings[i].Position = standings[i-1].Position
+		}
+	}
+	return standings
+}
+
+func sortedIDs(set map[uint]bool) []uint {
+	ids := make([]uint, 0, len(set))
+	for id := range set {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
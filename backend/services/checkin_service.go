@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"hackathon-platform/backend/models"
 	"hackathon-platform/backend/repositories"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -23,26 +24,48 @@ type CheckInService interface {
 	GetCheckInCount(eventID uint) (int64, error)
 	UpdateTxHash(id uint, txHash string) (*models.CheckIn, error)
 	DeleteCheckIn(id uint) error
+
+	AuthorizeKiosk(eventID uint, req *AuthorizeKioskRequest) (*models.CheckInKiosk, error)
+	ListKiosks(eventID uint) ([]models.CheckInKiosk, error)
+	RevokeKiosk(eventID uint, kioskID uint, organizerAddress string) (*models.CheckInKiosk, error)
+	SyncKioskBatch(req *KioskSyncRequest) (*KioskSyncResponse, error)
 }
 
 type checkInService struct {
 	checkInRepo repositories.CheckInRepository
 	eventRepo   repositories.EventRepository
 	teamRepo    repositories.TeamRepository
+	kioskRepo   repositories.CheckInKioskRepository
+	live        LiveNotifier
 }
 
 func NewCheckInService(
 	checkInRepo repositories.CheckInRepository,
 	eventRepo repositories.EventRepository,
 	teamRepo repositories.TeamRepository,
+	kioskRepo repositories.CheckInKioskRepository,
+	live LiveNotifier,
 ) CheckInService {
 	return &checkInService{
 		checkInRepo: checkInRepo,
 		eventRepo:   eventRepo,
 		teamRepo:    teamRepo,
+		kioskRepo:   kioskRepo,
+		live:        live,
 	}
 }
 
+// Kiosk sync item statuses
+const (
+	KioskItemCreated   = "created"
+	KioskItemDuplicate = "duplicate"
+	KioskItemRejected  = "rejected"
+)
+
+// kioskClockSkew is how far in the future an offline check-in time may be
+// before it is rejected, to tolerate drift on the staff device.
+const kioskClockSkew = 5 * time.Minute
+
 type CheckInQRCodeResponse struct {
 	EventID   uint      `json:"event_id"`
 	Message   string    `json:"message"`
@@ -58,6 +81,56 @@ type CheckInRequest struct {
 	TeamID      *uint  `json:"team_id"`
 	IPAddress   string `json:"ip_address"`
 	DeviceInfo  string `json:"device_info"`
+	// Client-reported location, validated against the event geofence if one is set
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
+	// Organizer personal_sign signature over geofenceOverrideMessage; lets a
+	// check-in through when the geofence check fails
+	OverrideSignature string `json:"override_signature"`
+}
+
+type AuthorizeKioskRequest struct {
+	StaffAddress     string `json:"staff_address" binding:"required"`
+	Label            string `json:"label"`
+	OrganizerAddress string `json:"organizer_address" binding:"required"`
+}
+
+// KioskCheckInItem is a single participant-signed check-in collected offline.
+type KioskCheckInItem struct {
+	UserAddress string    `json:"user_address" binding:"required"`
+	Message     string    `json:"message" binding:"required"`
+	Signature   string    `json:"signature" binding:"required"`
+	TeamID      *uint     `json:"team_id"`
+	CheckInTime time.Time `json:"check_in_time" binding:"required"`
+	DeviceInfo  string    `json:"device_info"`
+}
+
+// KioskSyncRequest is a batch of offline check-ins uploaded by a staff kiosk.
+// Signature is the staff key's personal_sign signature over kioskBatchMessage.
+type KioskSyncRequest struct {
+	EventID      uint               `json:"event_id" binding:"required"`
+	StaffAddress string             `json:"staff_address" binding:"required"`
+	BatchID      string             `json:"batch_id" binding:"required"`
+	Items        []KioskCheckInItem `json:"items" binding:"required,dive"`
+	Signature    string             `json:"signature" binding:"required"`
+	IPAddress    string             `json:"ip_address"`
+}
+
+type KioskSyncItemResult struct {
+	Index       int             `json:"index"`
+	UserAddress string          `json:"user_address"`
+	Status      string          `json:"status"`
+	Error       string          `json:"error,omitempty"`
+	CheckIn     *models.CheckIn `json:"check_in,omitempty"`
+}
+
+type KioskSyncResponse struct {
+	BatchID    string                `json:"batch_id"`
+	KioskID    uint                  `json:"kiosk_id"`
+	Created    int                   `json:"created"`
+	Duplicates int                   `json:"duplicates"`
+	Rejected   int                   `json:"rejected"`
+	Results    []KioskSyncItemResult `json:"results"`
 }
 
 func (s *checkInService) GenerateQRCode(eventID uint) (*CheckInQRCodeResponse, error) {
@@ -117,7 +190,7 @@ func (s *checkInService) VerifyAndCheckIn(req *CheckInRequest) (*models.CheckIn,
 	}
 
 	// Verify signature
-	err = s.verifySignature(req.UserAddress, req.Message, req.Signature)
+	err = verifyPersonalSignature(req.UserAddress, req.Message, req.Signature)
 	if err != nil {
 		return nil, fmt.Errorf("signature verification failed: %v", err)
 	}
@@ -129,18 +202,7 @@ func (s *checkInService) VerifyAndCheckIn(req *CheckInRequest) (*models.CheckIn,
 			return nil, errors.New("team not found")
 		}
 		// Verify user is member of team
-		isMember := false
-		if team.LeaderAddress == req.UserAddress {
-			isMember = true
-		} else {
-			for _, member := range team.Members {
-				if member.Address == req.UserAddress {
-					isMember = true
-					break
-				}
-			}
-		}
-		if !isMember {
+		if !isTeamMember(team, req.UserAddress) {
 			return nil, errors.New("user is not a member of the specified team")
 		}
 	}
@@ -154,6 +216,13 @@ func (s *checkInService) VerifyAndCheckIn(req *CheckInRequest) (*models.CheckIn,
 		CheckInTime: time.Now(),
 		IPAddress:   req.IPAddress,
 		DeviceInfo:  req.DeviceInfo,
+		Latitude:    req.Latitude,
+		Longitude:   req.Longitude,
+	}
+
+	// Validate location against the venue geofence
+	if err := applyGeofence(event, req, checkIn); err != nil {
+		return nil, err
 	}
 
 	err = s.checkInRepo.Create(checkIn)
@@ -161,10 +230,64 @@ func (s *checkInService) VerifyAndCheckIn(req *CheckInRequest) (*models.CheckIn,
 		return nil, err
 	}
 
+	notifyLive(s.live, checkIn.EventID, LiveTopicCheckIns)
 	return checkIn, nil
 }
 
-func (s *checkInService) verifySignature(address, message, signature string) error {
+// applyGeofence records the distance from the venue on checkIn and rejects
+// locations outside the fence unless the organizer signed an override.
+func applyGeofence(event *models.Event, req *CheckInRequest, checkIn *models.CheckIn) error {
+	fence, err := eventGeofence(event)
+	if err != nil {
+		return err
+	}
+	if fence == nil {
+		return nil
+	}
+
+	overridden := req.OverrideSignature != ""
+	if overridden {
+		if err := verifyPersonalSignature(event.OrganizerAddress, geofenceOverrideMessage(req), req.OverrideSignature); err != nil {
+			return fmt.Errorf("geofence override must be signed by the organizer: %v", err)
+		}
+	}
+
+	if req.Latitude == nil || req.Longitude == nil {
+		if !overridden {
+			return errors.New("location is required to check in to this event")
+		}
+		checkIn.OverrideBy = normalizeAddress(event.OrganizerAddress)
+		checkIn.OverrideSignature = req.OverrideSignature
+		return nil
+	}
+	if !validCoordinate(*req.Latitude, *req.Longitude) {
+		return errors.New("invalid location coordinates")
+	}
+
+	distance, within := fence.evaluate(geoPoint{Lat: *req.Latitude, Lng: *req.Longitude})
+	checkIn.DistanceMeters = &distance
+	if !within {
+		if !overridden {
+			return fmt.Errorf("location is outside the venue geofence (%.0f m)", distance)
+		}
+		checkIn.OverrideBy = normalizeAddress(event.OrganizerAddress)
+		checkIn.OverrideSignature = req.OverrideSignature
+	}
+	return nil
+}
+
+// geofenceOverrideMessage is the message the organizer signs to let one
+// participant check in despite a failed geofence check. It names the
+// participant's own check-in signature, so it cannot be reused for anyone
+// else or for another check-in.
+func geofenceOverrideMessage(req *CheckInRequest) string {
+	return fmt.Sprintf("Override geofence for event %d\nParticipant: %s\nCheck-in signature: %s",
+		req.EventID, normalizeAddress(req.UserAddress), strings.ToLower(req.Signature))
+}
+
+// verifyPersonalSignature checks that signature is an Ethereum personal_sign
+// signature of message by address.
+func verifyPersonalSignature(address, message, signature string) error {
 	// Remove 0x prefix if present
 	if len(signature) > 2 && signature[:2] == "0x" {
 		signature = signature[2:]
@@ -186,11 +309,16 @@ func (s *checkInService) verifySignature(address, message, signature string) err
 		return errors.New("invalid recovery id")
 	}
 
+	// crypto.SigToPub expects the raw recovery ID (0 or 1)
+	sig := make([]byte, len(sigBytes))
+	copy(sig, sigBytes)
+	sig[64] -= 27
+
 	// Create hash of message (Ethereum message prefix)
 	msgHash := crypto.Keccak256Hash([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(message), message)))
 
 	// Recover public key
-	pubKey, err := crypto.SigToPub(msgHash.Bytes(), sigBytes)
+	pubKey, err := crypto.SigToPub(msgHash.Bytes(), sig)
 	if err != nil {
 		return err
 	}
@@ -206,6 +334,23 @@ func (s *checkInService) verifySignature(address, message, signature string) err
 	return nil
 }
 
+// isTeamMember reports whether address is the leader or a member of team.
+func isTeamMember(team *models.Team, address string) bool {
+	address = normalizeAddress(address)
+	if address == "" {
+		return false
+	}
+	if normalizeAddress(team.LeaderAddress) == address {
+		return true
+	}
+	for _, member := range team.Members {
+		if normalizeAddress(member.Address) == address {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *checkInService) GetCheckIn(id uint) (*models.CheckIn, error) {
 	return s.checkInRepo.GetByID(id)
 }
@@ -238,5 +383,228 @@ func (s *checkInService) UpdateTxHash(id uint, txHash string) (*models.CheckIn,
 }
 
 func (s *checkInService) DeleteCheckIn(id uint) error {
-	return s.checkInRepo.Delete(id)
+	checkIn, err := s.checkInRepo.GetByID(id)
+	if err != nil {
+		return err
+	}
+	if err := s.checkInRepo.Delete(id); err != nil {
+		return err
+	}
+
+	notifyLive(s.live, checkIn.EventID, LiveTopicCheckIns)
+	return nil
+}
+
+func (s *checkInService) AuthorizeKiosk(eventID uint, req *AuthorizeKioskRequest) (*models.CheckInKiosk, error) {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+	if normalizeAddress(event.OrganizerAddress) != normalizeAddress(req.OrganizerAddress) {
+		return nil, errors.New("only the organizer can manage kiosks")
+	}
+	if !common.IsHexAddress(req.StaffAddress) {
+		return nil, errors.New("invalid staff address")
+	}
+	staffAddress := normalizeAddress(req.StaffAddress)
+
+	// Re-authorizing a revoked kiosk reactivates it
+	if existing, err := s.kioskRepo.GetByEventAndStaff(eventID, staffAddress); err == nil {
+		if existing.RevokedAt == nil {
+			return nil, errors.New("staff address is already authorized for this event")
+		}
+		existing.RevokedAt = nil
+		existing.Label = req.Label
+		existing.AuthorizedBy = normalizeAddress(req.OrganizerAddress)
+		if err := s.kioskRepo.Update(existing); err != nil {
+			return nil, err
+		}
+		return existing, nil
+	}
+
+	kiosk := &models.CheckInKiosk{
+		EventID:      eventID,
+		StaffAddress: staffAddress,
+		Label:        req.Label,
+		AuthorizedBy: normalizeAddress(req.OrganizerAddress),
+	}
+	if err := s.kioskRepo.Create(kiosk); err != nil {
+		return nil, err
+	}
+	return kiosk, nil
+}
+
+func (s *checkInService) ListKiosks(eventID uint) ([]models.CheckInKiosk, error) {
+	return s.kioskRepo.ListByEvent(eventID)
+}
+
+func (s *checkInService) RevokeKiosk(eventID uint, kioskID uint, organizerAddress string) (*models.CheckInKiosk, error) {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+	if normalizeAddress(event.OrganizerAddress) != normalizeAddress(organizerAddress) {
+		return nil, errors.New("only the organizer can manage kiosks")
+	}
+
+	kiosk, err := s.kioskRepo.GetByID(kioskID)
+	if err != nil {
+		return nil, err
+	}
+	if kiosk.EventID != eventID {
+		return nil, errors.New("kiosk does not belong to this event")
+	}
+	if kiosk.RevokedAt != nil {
+		return kiosk, nil
+	}
+
+	now := time.Now()
+	kiosk.RevokedAt = &now
+	if err := s.kioskRepo.Update(kiosk); err != nil {
+		return nil, err
+	}
+	return kiosk, nil
+}
+
+// SyncKioskBatch verifies the staff envelope of an offline batch and then
+// records each participant check-in independently. Envelope failures reject
+// the whole batch; item failures are reported per item.
+func (s *checkInService) SyncKioskBatch(req *KioskSyncRequest) (*KioskSyncResponse, error) {
+	event, err := s.eventRepo.GetByID(req.EventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+
+	kiosk, err := s.kioskRepo.GetByEventAndStaff(event.ID, normalizeAddress(req.StaffAddress))
+	if err != nil {
+		return nil, errors.New("staff address is not an authorized kiosk for this event")
+	}
+	if kiosk.RevokedAt != nil {
+		return nil, errors.New("kiosk authorization has been revoked")
+	}
+
+	if err := verifyPersonalSignature(kiosk.StaffAddress, kioskBatchMessage(req), req.Signature); err != nil {
+		return nil, fmt.Errorf("staff signature verification failed: %v", err)
+	}
+
+	resp := &KioskSyncResponse{
+		BatchID: req.BatchID,
+		KioskID: kiosk.ID,
+		Results: make([]KioskSyncItemResult, 0, len(req.Items)),
+	}
+	seen := make(map[string]bool)
+
+	for i := range req.Items {
+		item := &req.Items[i]
+		result := KioskSyncItemResult{Index: i, UserAddress: item.UserAddress}
+
+		address := normalizeAddress(item.UserAddress)
+		if seen[address] {
+			result.Status = KioskItemDuplicate
+			result.Error = "user appears more than once in this batch"
+		} else if checkIn, status, err := s.recordKioskItem(event, kiosk, item, req.IPAddress); err != nil {
+			result.Status = status
+			result.Error = err.Error()
+		} else {
+			result.Status = KioskItemCreated
+			result.CheckIn = checkIn
+			seen[address] = true
+		}
+
+		switch result.Status {
+		case KioskItemCreated:
+			resp.Created++
+		case KioskItemDuplicate:
+			resp.Duplicates++
+		default:
+			resp.Rejected++
+		}
+		resp.Results = append(resp.Results, result)
+	}
+
+	now := time.Now()
+	kiosk.LastSyncAt = &now
+	if err := s.kioskRepo.Update(kiosk); err != nil {
+		return nil, err
+	}
+
+	if resp.Created > 0 {
+		notifyLive(s.live, event.ID, LiveTopicCheckIns)
+	}
+	return resp, nil
+}
+
+func (s *checkInService) recordKioskItem(event *models.Event, kiosk *models.CheckInKiosk, item *KioskCheckInItem, ipAddress string) (*models.CheckIn, string, error) {
+	if !common.IsHexAddress(item.UserAddress) {
+		return nil, KioskItemRejected, errors.New("invalid user address")
+	}
+
+	// The participant must have signed a message bound to this event so that
+	// signatures collected for one event cannot be replayed into another.
+	if !strings.HasPrefix(item.Message, fmt.Sprintf("Check-in for event %d\n", event.ID)) {
+		return nil, KioskItemRejected, errors.New("signed message does not reference this event")
+	}
+
+	if item.CheckInTime.After(time.Now().Add(kioskClockSkew)) {
+		return nil, KioskItemRejected, errors.New("check-in time is in the future")
+	}
+	if event.CheckInStartTime != nil && item.CheckInTime.Before(*event.CheckInStartTime) {
+		return nil, KioskItemRejected, errors.New("check-in time is before the check-in window")
+	}
+	if event.CheckInEndTime != nil && item.CheckInTime.After(*event.CheckInEndTime) {
+		return nil, KioskItemRejected, errors.New("check-in time is after the check-in window")
+	}
+
+	if err := verifyPersonalSignature(item.UserAddress, item.Message, item.Signature); err != nil {
+		return nil, KioskItemRejected, fmt.Errorf("signature verification failed: %v", err)
+	}
+
+	if existing, _ := s.checkInRepo.GetByUserAndEvent(item.UserAddress, event.ID); existing != nil {
+		return nil, KioskItemDuplicate, errors.New("user already checked in")
+	}
+
+	if item.TeamID != nil {
+		team, err := s.teamRepo.GetByID(*item.TeamID)
+		if err != nil {
+			return nil, KioskItemRejected, errors.New("team not found")
+		}
+		if !isTeamMember(team, item.UserAddress) {
+			return nil, KioskItemRejected, errors.New("user is not a member of the specified team")
+		}
+	}
+
+	kioskID := kiosk.ID
+	checkIn := &models.CheckIn{
+		EventID:     event.ID,
+		UserAddress: item.UserAddress,
+		TeamID:      item.TeamID,
+		Signature:   item.Signature,
+		Message:     item.Message,
+		CheckInTime: item.CheckInTime,
+		IPAddress:   ipAddress,
+		DeviceInfo:  item.DeviceInfo,
+		KioskID:     &kioskID,
+	}
+	if err := s.checkInRepo.Create(checkIn); err != nil {
+		return nil, KioskItemRejected, err
+	}
+	return checkIn, KioskItemCreated, nil
+}
+
+// kioskBatchMessage builds the envelope the staff key signs. The digest is
+// keccak256 over one line per item ("address|signature|unix check-in time"),
+// so the staff signature commits to the exact participant payloads.
+func kioskBatchMessage(req *KioskSyncRequest) string {
+	lines := make([]string, 0, len(req.Items))
+	for _, item := range req.Items {
+		lines = append(lines, fmt.Sprintf("%s|%s|%d",
+			normalizeAddress(item.UserAddress),
+			strings.ToLower(item.Signature),
+			item.CheckInTime.Unix(),
+		))
+	}
+	digest := crypto.Keccak256Hash([]byte(strings.Join(lines, "\n")))
+
+	return fmt.Sprintf("Kiosk check-in batch for event %d\nBatch: %s\nItems: %d\nDigest: %s",
+		req.EventID, req.BatchID, len(req.Items), digest.Hex())
 }
@@ -0,0 +1,182 @@
+package services
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"hackathon-platform/backend/models"
+	"hackathon-platform/backend/repositories"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"gorm.io/gorm"
+)
+
+// testWallet is a real key that signs the way wallets do for personal_sign.
+type testWallet struct {
+	key     *ecdsa.PrivateKey
+	address string
+}
+
+func newTestWallet(t *testing.T) *testWallet {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testWallet{key: key, address: strings.ToLower(crypto.PubkeyToAddress(key.PublicKey).Hex())}
+}
+
+// sign returns a personal_sign signature over message with v set to 27 or 28.
+func (w *testWallet) sign(t *testing.T, message string) string {
+	t.Helper()
+	hash := crypto.Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(message), message)))
+	sig, err := crypto.Sign(hash, w.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig[64] += 27
+	return hexutil.Encode(sig)
+}
+
+type memoryCheckInRepo struct {
+	repositories.CheckInRepository
+	created []models.CheckIn
+}
+
+func (r *memoryCheckInRepo) Create(checkIn *models.CheckIn) error {
+	checkIn.ID = uint(len(r.created) + 1)
+	r.created = append(r.created, *checkIn)
+	return nil
+}
+
+func (r *memoryCheckInRepo) GetByUserAndEvent(userAddress string, eventID uint) (*models.CheckIn, error) {
+	for i := range r.created {
+		if r.created[i].EventID == eventID && normalizeAddress(r.created[i].UserAddress) == normalizeAddress(userAddress) {
+			return &r.created[i], nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+type memoryKioskRepo struct {
+	repositories.CheckInKioskRepository
+	kiosk *models.CheckInKiosk
+}
+
+func (r *memoryKioskRepo) GetByEventAndStaff(eventID uint, staffAddress string) (*models.CheckInKiosk, error) {
+	if r.kiosk.EventID != eventID || r.kiosk.StaffAddress != staffAddress {
+		return nil, errors.New("record not found")
+	}
+	return r.kiosk, nil
+}
+
+func (r *memoryKioskRepo) Update(kiosk *models.CheckInKiosk) error {
+	return nil
+}
+
+func TestVerifyPersonalSignatureAcceptsWalletSignatures(t *testing.T) {
+	wallet := newTestWallet(t)
+	signature := wallet.sign(t, "hello")
+
+	if err := verifyPersonalSignature(wallet.address, "hello", signature); err != nil {
+		t.Fatalf("valid signature rejected: %v", err)
+	}
+	if err := verifyPersonalSignature(wallet.address, "hello!", signature); err == nil {
+		t.Fatal("signature over another message was accepted")
+	}
+	if err := verifyPersonalSignature(newTestWallet(t).address, "hello", signature); err == nil {
+		t.Fatal("signature was accepted for another address")
+	}
+}
+
+func TestSyncKioskBatchWithSignedItems(t *testing.T) {
+	staff := newTestWallet(t)
+	participants := []*testWallet{newTestWallet(t), newTestWallet(t)}
+
+	event := &models.Event{ID: 1, OrganizerAddress: testOrganizer, CurrentStage: models.StageCheckIn}
+	checkIns := &memoryCheckInRepo{}
+	kiosks := &memoryKioskRepo{kiosk: &models.CheckInKiosk{ID: 4, EventID: event.ID, StaffAddress: staff.address}}
+	service := NewCheckInService(checkIns, &stubEventRepo{event: event}, nil, kiosks, nil)
+
+	checkedInAt := time.Now().Add(-time.Hour).Truncate(time.Second)
+	req := &KioskSyncRequest{EventID: event.ID, StaffAddress: staff.address, BatchID: "batch-1"}
+	for _, participant := range participants {
+		message := fmt.Sprintf("Check-in for event %d\nEvent: Test\nSecret: abc\nTimestamp: %d", event.ID, checkedInAt.Unix())
+		req.Items = append(req.Items, KioskCheckInItem{
+			UserAddress: participant.address,
+			Message:     message,
+			Signature:   participant.sign(t, message),
+			CheckInTime: checkedInAt,
+		})
+	}
+	req.Signature = staff.sign(t, kioskBatchMessage(req))
+
+	resp, err := service.SyncKioskBatch(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Created != 2 || resp.Rejected != 0 || resp.Duplicates != 0 {
+		t.Fatalf("sync created %d, rejected %d, duplicates %d: %+v", resp.Created, resp.Rejected, resp.Duplicates, resp.Results)
+	}
+	if len(checkIns.created) != 2 || checkIns.created[0].KioskID == nil || *checkIns.created[0].KioskID != 4 {
+		t.Fatalf("check-ins were not recorded for the kiosk: %+v", checkIns.created)
+	}
+
+	// Tampering with an item breaks the staff envelope
+	req.Items[0].CheckInTime = checkedInAt.Add(-time.Minute)
+	if _, err := service.SyncKioskBatch(req); err == nil {
+		t.Fatal("batch with a modified item was accepted")
+	}
+}
+
+func TestGeofenceOverrideSignedByOrganizer(t *testing.T) {
+	organizer := newTestWallet(t)
+	participant := newTestWallet(t)
+	lat, lng, radius := 31.2304, 121.4737, 200.0
+	event := &models.Event{
+		ID:               1,
+		OrganizerAddress: organizer.address,
+		CurrentStage:     models.StageCheckIn,
+		GeofenceLat:      &lat,
+		GeofenceLng:      &lng,
+		GeofenceRadius:   &radius,
+	}
+	checkIns := &memoryCheckInRepo{}
+	service := NewCheckInService(checkIns, &stubEventRepo{event: event}, nil, nil, nil)
+
+	// About 11 km north of the venue
+	farLat := lat + 0.1
+	message := fmt.Sprintf("Check-in for event %d\nEvent: Test\nSecret: abc\nTimestamp: %d", event.ID, time.Now().Unix())
+	req := &CheckInRequest{
+		EventID:     event.ID,
+		UserAddress: participant.address,
+		Message:     message,
+		Signature:   participant.sign(t, message),
+		Latitude:    &farLat,
+		Longitude:   &lng,
+	}
+	if _, err := service.VerifyAndCheckIn(req); err == nil {
+		t.Fatal("check-in outside the geofence was accepted without an override")
+	}
+
+	req.OverrideSignature = participant.sign(t, geofenceOverrideMessage(req))
+	if _, err := service.VerifyAndCheckIn(req); err == nil {
+		t.Fatal("override signed by the participant was accepted")
+	}
+
+	req.OverrideSignature = organizer.sign(t, geofenceOverrideMessage(req))
+	checkIn, err := service.VerifyAndCheckIn(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if checkIn.OverrideBy != organizer.address || checkIn.OverrideSignature != req.OverrideSignature {
+		t.Fatalf("override was not recorded: by %q", checkIn.OverrideBy)
+	}
+	if checkIn.DistanceMeters == nil || *checkIn.DistanceMeters < 10000 {
+		t.Fatalf("distance = %v, want about 11 km", checkIn.DistanceMeters)
+	}
+}
@@ -0,0 +1,261 @@
+package services
+
+import (
+	"errors"
+	"hackathon-platform/backend/models"
+	"hackathon-platform/backend/repositories"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Conflict-of-interest rules a vote or scorecard can break.
+const (
+	ConflictRuleTeamMember = "team_member"       // The voter is the leader or a member of the submission's team
+	ConflictRuleDeclared   = "declared_conflict" // The voter declared a conflict with the submission's team
+)
+
+// ConflictService manages declared judge conflicts and reports votes that
+// break conflict-of-interest rules.
+type ConflictService interface {
+	DeclareConflict(eventID uint, req *DeclareConflictRequest) (*models.JudgeConflict, error)
+	ListConflicts(eventID uint, judgeAddress string) ([]models.JudgeConflict, error)
+	RemoveConflict(eventID uint, conflictID uint, organizerAddress string) error
+	GetViolations(eventID uint, organizerAddress string) ([]ConflictViolation, error)
+}
+
+type conflictService struct {
+	conflictRepo   repositories.JudgeConflictRepository
+	eventJudgeRepo repositories.EventJudgeRepository
+	eventRepo      repositories.EventRepository
+	teamRepo       repositories.TeamRepository
+	submissionRepo repositories.SubmissionRepository
+	voteRepo       repositories.VoteRepository
+	rubricRepo     repositories.RubricRepository
+}
+
+func NewConflictService(
+	conflictRepo repositories.JudgeConflictRepository,
+	eventJudgeRepo repositories.EventJudgeRepository,
+	eventRepo repositories.EventRepository,
+	teamRepo repositories.TeamRepository,
+	submissionRepo repositories.SubmissionRepository,
+	voteRepo repositories.VoteRepository,
+	rubricRepo repositories.RubricRepository,
+) ConflictService {
+	return &conflictService{
+		conflictRepo:   conflictRepo,
+		eventJudgeRepo: eventJudgeRepo,
+		eventRepo:      eventRepo,
+		teamRepo:       teamRepo,
+		submissionRepo: submissionRepo,
+		voteRepo:       voteRepo,
+		rubricRepo:     rubricRepo,
+	}
+}
+
+// DeclareConflictRequest records a judge's conflict with a team.
+type DeclareConflictRequest struct {
+	JudgeAddress string `json:"judge_address" binding:"required"`
+	TeamID       uint   `json:"team_id" binding:"required"`
+	Reason       string `json:"reason"`
+}
+
+// ConflictViolation is a vote or rubric scorecard cast despite a conflict
+// of interest.
+type ConflictViolation struct {
+	Kind         string           `json:"kind"` // vote or scorecard
+	VoteID       uint             `json:"vote_id,omitempty"`
+	SubmissionID uint             `json:"submission_id"`
+	TeamID       uint             `json:"team_id"`
+	VoterAddress string           `json:"voter_address"`
+	VoterType    models.VoterType `json:"voter_type"`
+	Rule         string           `json:"rule"`
+	CastAt       time.Time        `json:"cast_at"`
+}
+
+// DeclareConflict lets a whitelisted judge recuse themselves from a team's
+// submissions. Declared conflicts block votes and scorecards and are
+// respected by judge assignment.
+func (s *conflictService) DeclareConflict(eventID uint, req *DeclareConflictRequest) (*models.JudgeConflict, error) {
+	address := normalizeAddress(req.JudgeAddress)
+	if address == "" {
+		return nil, errors.New("invalid judge address")
+	}
+	if _, err := s.eventRepo.GetByID(eventID); err != nil {
+		return nil, errors.New("event not found")
+	}
+	if _, err := s.eventJudgeRepo.GetByEventAndAddress(eventID, address); err != nil {
+		return nil, errors.New("address is not on the judge whitelist")
+	}
+	if _, err := s.teamRepo.GetByID(req.TeamID); err != nil {
+		return nil, errors.New("team not found")
+	}
+
+	exists, err := s.conflictRepo.Exists(eventID, address, req.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errors.New("conflict already declared for this team")
+	}
+
+	conflict := &models.JudgeConflict{
+		EventID:      eventID,
+		JudgeAddress: address,
+		TeamID:       req.TeamID,
+		Reason:       strings.TrimSpace(req.Reason),
+	}
+	if err := s.conflictRepo.Create(conflict); err != nil {
+		return nil, err
+	}
+	return conflict, nil
+}
+
+func (s *conflictService) ListConflicts(eventID uint, judgeAddress string) ([]models.JudgeConflict, error) {
+	if judgeAddress != "" {
+		return s.conflictRepo.ListByJudge(eventID, normalizeAddress(judgeAddress))
+	}
+	return s.conflictRepo.ListByEvent(eventID)
+}
+
+// RemoveConflict withdraws a declaration. Only the organizer can do this so
+// judges cannot lift a recusal on their own.
+func (s *conflictService) RemoveConflict(eventID uint, conflictID uint, organizerAddress string) error {
+	if err := s.checkOrganizer(eventID, organizerAddress); err != nil {
+		return err
+	}
+	conflict, err := s.conflictRepo.GetByID(conflictID)
+	if err != nil {
+		return err
+	}
+	if conflict.EventID != eventID {
+		return errors.New("conflict does not belong to this event")
+	}
+	return s.conflictRepo.Delete(conflictID)
+}
+
+// GetViolations checks every vote and scorecard of the event against the
+// current team rosters and declared conflicts, including those cast before
+// the rules were enforced.
+func (s *conflictService) GetViolations(eventID uint, organizerAddress string) ([]ConflictViolation, error) {
+	if err := s.checkOrganizer(eventID, organizerAddress); err != nil {
+		return nil, err
+	}
+
+	conflicts, err := s.conflictRepo.ListByEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+	declared := declaredConflicts(conflicts)
+
+	submissions, err := s.submissionRepo.GetByEventID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	teams := make(map[uint]*models.Team)
+	teamBySubmission := make(map[uint]uint, len(submissions))
+	for _, submission := range submissions {
+		teamBySubmission[submission.ID] = submission.TeamID
+		if _, ok := teams[submission.TeamID]; ok {
+			continue
+		}
+		team, err := s.teamRepo.GetByID(submission.TeamID)
+		if err != nil {
+			return nil, err
+		}
+		teams[submission.TeamID] = team
+	}
+	rule := func(submissionID uint, address string) (uint, string) {
+		teamID, ok := teamBySubmission[submissionID]
+		if !ok {
+			return 0, ""
+		}
+		return teamID, voteConflict(teams[teamID], address, declared[teamID][normalizeAddress(address)])
+	}
+
+	violations := []ConflictViolation{}
+	votes, err := s.voteRepo.GetByEventID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	for _, vote := range votes {
+		if teamID, broken := rule(vote.SubmissionID, vote.VoterAddress); broken != "" {
+			violations = append(violations, ConflictViolation{
+				Kind:         "vote",
+				VoteID:       vote.ID,
+				SubmissionID: vote.SubmissionID,
+				TeamID:       teamID,
+				VoterAddress: vote.VoterAddress,
+				VoterType:    vote.VoterType,
+				Rule:         broken,
+				CastAt:       vote.CreatedAt,
+			})
+		}
+	}
+
+	scores, err := s.rubricRepo.ListScoresByEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	for _, score := range scores {
+		key := judgeKey(score.SubmissionID, score.JudgeAddress)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if teamID, broken := rule(score.SubmissionID, score.JudgeAddress); broken != "" {
+			violations = append(violations, ConflictViolation{
+				Kind:         "scorecard",
+				SubmissionID: score.SubmissionID,
+				TeamID:       teamID,
+				VoterAddress: score.JudgeAddress,
+				VoterType:    models.VoterTypeJudge,
+				Rule:         broken,
+				CastAt:       score.CreatedAt,
+			})
+		}
+	}
+
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].CastAt.Before(violations[j].CastAt)
+	})
+	return violations, nil
+}
+
+func (s *conflictService) checkOrganizer(eventID uint, organizerAddress string) error {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		return errors.New("event not found")
+	}
+	if normalizeAddress(event.OrganizerAddress) != normalizeAddress(organizerAddress) {
+		return errors.New("only the organizer can manage conflicts of interest")
+	}
+	return nil
+}
+
+// voteConflict returns the rule broken when address votes for a submission
+// of team, or "" when there is none. declared reports whether address
+// declared a conflict with the team.
+func voteConflict(team *models.Team, address string, declared bool) string {
+	if team != nil && isTeamMember(team, address) {
+		return ConflictRuleTeamMember
+	}
+	if declared {
+		return ConflictRuleDeclared
+	}
+	return ""
+}
+
+// declaredConflicts indexes conflicts by team and judge address.
+func declaredConflicts(conflicts []models.JudgeConflict) map[uint]map[string]bool {
+	declared := make(map[uint]map[string]bool)
+	for _, conflict := range conflicts {
+		if declared[conflict.TeamID] == nil {
+			declared[conflict.TeamID] = make(map[string]bool)
+		}
+		declared[conflict.TeamID][normalizeAddress(conflict.JudgeAddress)] = true
+	}
+	return declared
+}
@@ -36,12 +36,32 @@ type CreateEventRequest struct {
 	CheckInEndTime        *time.Time             `json:"checkin_end_time"`
 	SubmissionStartTime   *time.Time             `json:"submission_start_time"`
 	SubmissionEndTime     *time.Time             `json:"submission_end_time"`
+	SubmissionGraceMins   int                    `json:"submission_grace_minutes"`
+	RequireMemberSignoff  bool                   `json:"require_member_signoff"`
 	VotingStartTime       *time.Time             `json:"voting_start_time"`
 	VotingEndTime         *time.Time             `json:"voting_end_time"`
+	CommitReveal          bool                   `json:"commit_reveal"`
+	RevealEndTime         *time.Time             `json:"reveal_end_time"`
 	OrganizerAddress      string                 `json:"organizer_address" binding:"required"`
 	AllowSponsorVoting    bool                   `json:"allow_sponsor_voting"`
 	AllowPublicVoting     bool                   `json:"allow_public_voting"`
+	PublicVotingMode      models.PublicVotingMode `json:"public_voting_mode"`
+	PublicVoteCredits     int                    `json:"public_vote_credits"`
+	PublicRequireSBT      bool                   `json:"public_require_sbt"`
+	PublicRequireCheckIn  bool                   `json:"public_require_checkin"`
+	PublicRequireAllowlist bool                  `json:"public_require_allowlist"`
+	PublicRequirePersonhood bool                 `json:"public_require_personhood"`
+	BallotMethod          models.BallotMethod    `json:"ballot_method"`
+	BallotMaxRanks        int                    `json:"ballot_max_ranks"`
+	ScoreNormalization    models.ScoreNormalization `json:"score_normalization"`
 	OnChain               bool                   `json:"on_chain"`
+	GeofenceLat           *float64               `json:"geofence_latitude"`
+	GeofenceLng           *float64               `json:"geofence_longitude"`
+	GeofenceRadius        *float64               `json:"geofence_radius_meters"`
+	GeofencePolygon       string                 `json:"geofence_polygon"`
+	GeofenceTolerance     float64                `json:"geofence_tolerance_meters"`
+	MaxUploadBytes        int64                  `json:"max_upload_bytes"`
+	AllowedUploadTypes    string                 `json:"allowed_upload_types"`
 	Prizes                []CreatePrizeRequest   `json:"prizes"`
 }
 
@@ -64,10 +84,31 @@ type UpdateEventRequest struct {
 	CheckInEndTime        *time.Time             `json:"checkin_end_time"`
 	SubmissionStartTime   *time.Time             `json:"submission_start_time"`
 	SubmissionEndTime     *time.Time             `json:"submission_end_time"`
+	SubmissionGraceMins   *int                   `json:"submission_grace_minutes"`
+	RequireMemberSignoff  *bool                  `json:"require_member_signoff"`
 	VotingStartTime       *time.Time             `json:"voting_start_time"`
 	VotingEndTime         *time.Time             `json:"voting_end_time"`
+	CommitReveal          *bool                  `json:"commit_reveal"`
+	RevealEndTime         *time.Time             `json:"reveal_end_time"`
 	AllowSponsorVoting    *bool                  `json:"allow_sponsor_voting"`
 	AllowPublicVoting     *bool                  `json:"allow_public_voting"`
+	PublicVotingMode      *models.PublicVotingMode `json:"public_voting_mode"`
+	PublicVoteCredits     *int                   `json:"public_vote_credits"`
+	PublicRequireSBT      *bool                  `json:"public_require_sbt"`
+	PublicRequireCheckIn  *bool                  `json:"public_require_checkin"`
+	PublicRequireAllowlist *bool                 `json:"public_require_allowlist"`
+	PublicRequirePersonhood *bool                `json:"public_require_personhood"`
+	BallotMethod          *models.BallotMethod   `json:"ballot_method"`
+	BallotMaxRanks        *int                   `json:"ballot_max_ranks"`
+	ResultsFrozen         *bool                  `json:"results_frozen"`
+	ScoreNormalization    *models.ScoreNormalization `json:"score_normalization"`
+	GeofenceLat           *float64               `json:"geofence_latitude"`
+	GeofenceLng           *float64               `json:"geofence_longitude"`
+	GeofenceRadius        *float64               `json:"geofence_radius_meters"`
+	GeofencePolygon       *string                `json:"geofence_polygon"`
+	GeofenceTolerance     *float64               `json:"geofence_tolerance_meters"`
+	MaxUploadBytes        *int64                 `json:"max_upload_bytes"`
+	AllowedUploadTypes    *string                `json:"allowed_upload_types"`
 	Prizes                []CreatePrizeRequest   `json:"prizes"`
 }
 
@@ -89,13 +130,61 @@ func (s *eventService) CreateEvent(req *CreateEventRequest) (*models.Event, erro
 		CheckInEndTime:        req.CheckInEndTime,
 		SubmissionStartTime:   req.SubmissionStartTime,
 		SubmissionEndTime:     req.SubmissionEndTime,
+		SubmissionGraceMins:   req.SubmissionGraceMins,
+		RequireMemberSignoff:  req.RequireMemberSignoff,
 		VotingStartTime:       req.VotingStartTime,
 		VotingEndTime:         req.VotingEndTime,
+		CommitReveal:          req.CommitReveal,
+		RevealEndTime:         req.RevealEndTime,
 		CurrentStage:          models.StageRegistration,
 		OrganizerAddress:      req.OrganizerAddress,
 		AllowSponsorVoting:    req.AllowSponsorVoting,
 		AllowPublicVoting:     req.AllowPublicVoting,
+		PublicVotingMode:      req.PublicVotingMode,
+		PublicVoteCredits:     req.PublicVoteCredits,
+		PublicRequireSBT:      req.PublicRequireSBT,
+		PublicRequireCheckIn:  req.PublicRequireCheckIn,
+		PublicRequireAllowlist: req.PublicRequireAllowlist,
+		PublicRequirePersonhood: req.PublicRequirePersonhood,
+		BallotMethod:          req.BallotMethod,
+		BallotMaxRanks:        req.BallotMaxRanks,
+		ScoreNormalization:    req.ScoreNormalization,
 		OnChain:               req.OnChain,
+		GeofenceLat:           req.GeofenceLat,
+		GeofenceLng:           req.GeofenceLng,
+		GeofenceRadius:        req.GeofenceRadius,
+		GeofencePolygon:       req.GeofencePolygon,
+		GeofenceTolerance:     req.GeofenceTolerance,
+		MaxUploadBytes:        req.MaxUploadBytes,
+		AllowedUploadTypes:    req.AllowedUploadTypes,
+	}
+
+	if _, err := eventGeofence(event); err != nil {
+		return nil, err
+	}
+	if event.MaxUploadBytes < 0 {
+		return nil, errors.New("max upload bytes cannot be negative")
+	}
+	if event.SubmissionGraceMins < 0 {
+		return nil, errors.New("submission grace period cannot be negative")
+	}
+	if event.PublicVotingMode == "" {
+		event.PublicVotingMode = models.PublicVotingStandard
+	}
+	if err := validatePublicVoting(event); err != nil {
+		return nil, err
+	}
+	if err := validateBallots(event); err != nil {
+		return nil, err
+	}
+	if err := validateCommitReveal(event); err != nil {
+		return nil, err
+	}
+	if event.ScoreNormalization == "" {
+		event.ScoreNormalization = models.NormalizationNone
+	}
+	if !validScoreNormalization(event.ScoreNormalization) {
+		return nil, errors.New("unsupported score normalization")
 	}
 
 	// Create prizes
@@ -164,6 +253,15 @@ func (s *eventService) UpdateEvent(id uint, req *UpdateEventRequest) (*models.Ev
 	if req.SubmissionEndTime != nil {
 		event.SubmissionEndTime = req.SubmissionEndTime
 	}
+	if req.SubmissionGraceMins != nil {
+		if *req.SubmissionGraceMins < 0 {
+			return nil, errors.New("submission grace period cannot be negative")
+		}
+		event.SubmissionGraceMins = *req.SubmissionGraceMins
+	}
+	if req.RequireMemberSignoff != nil {
+		event.RequireMemberSignoff = *req.RequireMemberSignoff
+	}
 	if req.VotingStartTime != nil {
 		event.VotingStartTime = req.VotingStartTime
 	}
@@ -176,6 +274,87 @@ func (s *eventService) UpdateEvent(id uint, req *UpdateEventRequest) (*models.Ev
 	if req.AllowPublicVoting != nil {
 		event.AllowPublicVoting = *req.AllowPublicVoting
 	}
+	if req.PublicVotingMode != nil {
+		event.PublicVotingMode = *req.PublicVotingMode
+	}
+	if req.PublicVoteCredits != nil {
+		event.PublicVoteCredits = *req.PublicVoteCredits
+	}
+	if req.PublicRequireSBT != nil {
+		event.PublicRequireSBT = *req.PublicRequireSBT
+	}
+	if req.PublicRequireCheckIn != nil {
+		event.PublicRequireCheckIn = *req.PublicRequireCheckIn
+	}
+	if req.PublicRequireAllowlist != nil {
+		event.PublicRequireAllowlist = *req.PublicRequireAllowlist
+	}
+	if req.PublicRequirePersonhood != nil {
+		event.PublicRequirePersonhood = *req.PublicRequirePersonhood
+	}
+	if err := validatePublicVoting(event); err != nil {
+		return nil, err
+	}
+	if req.BallotMethod != nil {
+		event.BallotMethod = *req.BallotMethod
+	}
+	if req.BallotMaxRanks != nil {
+		event.BallotMaxRanks = *req.BallotMaxRanks
+	}
+	if err := validateBallots(event); err != nil {
+		return nil, err
+	}
+	if req.CommitReveal != nil {
+		event.CommitReveal = *req.CommitReveal
+	}
+	if req.RevealEndTime != nil {
+		event.RevealEndTime = req.RevealEndTime
+	}
+	if err := validateCommitReveal(event); err != nil {
+		return nil, err
+	}
+	if req.ResultsFrozen != nil {
+		event.ResultsFrozen = *req.ResultsFrozen
+	}
+	if req.ScoreNormalization != nil {
+		if !validScoreNormalization(*req.ScoreNormalization) {
+			return nil, errors.New("unsupported score normalization")
+		}
+		event.ScoreNormalization = *req.ScoreNormalization
+	}
+	if req.GeofenceLat != nil {
+		event.GeofenceLat = req.GeofenceLat
+	}
+	if req.GeofenceLng != nil {
+		event.GeofenceLng = req.GeofenceLng
+	}
+	if req.GeofenceRadius != nil {
+		event.GeofenceRadius = req.GeofenceRadius
+		// A non-positive radius removes the circular geofence
+		if *req.GeofenceRadius <= 0 {
+			event.GeofenceLat = nil
+			event.GeofenceLng = nil
+			event.GeofenceRadius = nil
+		}
+	}
+	if req.GeofencePolygon != nil {
+		event.GeofencePolygon = *req.GeofencePolygon
+	}
+	if req.GeofenceTolerance != nil {
+		event.GeofenceTolerance = *req.GeofenceTolerance
+	}
+	if _, err := eventGeofence(event); err != nil {
+		return nil, err
+	}
+	if req.MaxUploadBytes != nil {
+		if *req.MaxUploadBytes < 0 {
+			return nil, errors.New("max upload bytes cannot be negative")
+		}
+		event.MaxUploadBytes = *req.MaxUploadBytes
+	}
+	if req.AllowedUploadTypes != nil {
+		event.AllowedUploadTypes = *req.AllowedUploadTypes
+	}
 
 	// Update prizes if provided
 	if req.Prizes != nil {
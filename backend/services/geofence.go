@@ -0,0 +1,140 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"hackathon-platform/backend/models"
+	"math"
+)
+
+const earthRadiusMeters = 6371000.0
+
+type geoPoint struct {
+	Lat float64
+	Lng float64
+}
+
+// venueGeofence is the parsed form of an event's optional venue fence:
+// either a circle (center plus radius) or a polygon of [lat, lng] vertices.
+type venueGeofence struct {
+	center    *geoPoint
+	radius    float64
+	polygon   []geoPoint
+	tolerance float64
+}
+
+func validCoordinate(lat, lng float64) bool {
+	return lat >= -90 && lat <= 90 && lng >= -180 && lng <= 180
+}
+
+// parsePolygon decodes a JSON array of [lat, lng] pairs.
+func parsePolygon(raw string) ([]geoPoint, error) {
+	var pairs [][]float64
+	if err := json.Unmarshal([]byte(raw), &pairs); err != nil {
+		return nil, errors.New("geofence polygon must be a JSON array of [lat, lng] pairs")
+	}
+	if len(pairs) < 3 {
+		return nil, errors.New("geofence polygon needs at least 3 vertices")
+	}
+	points := make([]geoPoint, 0, len(pairs))
+	for _, pair := range pairs {
+		if len(pair) != 2 || !validCoordinate(pair[0], pair[1]) {
+			return nil, errors.New("geofence polygon contains an invalid coordinate")
+		}
+		points = append(points, geoPoint{Lat: pair[0], Lng: pair[1]})
+	}
+	return points, nil
+}
+
+// eventGeofence returns the event's geofence, or nil when none is configured.
+func eventGeofence(event *models.Event) (*venueGeofence, error) {
+	fence := &venueGeofence{tolerance: math.Max(event.GeofenceTolerance, 0)}
+
+	if event.GeofencePolygon != "" {
+		polygon, err := parsePolygon(event.GeofencePolygon)
+		if err != nil {
+			return nil, err
+		}
+		fence.polygon = polygon
+		return fence, nil
+	}
+
+	if event.GeofenceLat == nil || event.GeofenceLng == nil {
+		return nil, nil
+	}
+	if !validCoordinate(*event.GeofenceLat, *event.GeofenceLng) {
+		return nil, errors.New("geofence center is not a valid coordinate")
+	}
+	if event.GeofenceRadius == nil || *event.GeofenceRadius <= 0 {
+		return nil, errors.New("geofence radius must be greater than zero")
+	}
+	fence.center = &geoPoint{Lat: *event.GeofenceLat, Lng: *event.GeofenceLng}
+	fence.radius = *event.GeofenceRadius
+	return fence, nil
+}
+
+// evaluate returns the distance used for the decision and whether the point is
+// accepted. For a circle the distance is measured from the center; for a
+// polygon it is the distance outside the boundary (0 when inside).
+func (g *venueGeofence) evaluate(p geoPoint) (float64, bool) {
+	if g.center != nil {
+		distance := haversineMeters(*g.center, p)
+		return distance, distance <= g.radius+g.tolerance
+	}
+
+	if pointInPolygon(p, g.polygon) {
+		return 0, true
+	}
+	distance := math.Inf(1)
+	for i := range g.polygon {
+		a := g.polygon[i]
+		b := g.polygon[(i+1)%len(g.polygon)]
+		distance = math.Min(distance, distanceToSegmentMeters(p, a, b))
+	}
+	return distance, distance <= g.tolerance
+}
+
+func haversineMeters(a, b geoPoint) float64 {
+	lat1 := a.Lat * math.Pi / 180
+	lat2 := b.Lat * math.Pi / 180
+	dLat := (b.Lat - a.Lat) * math.Pi / 180
+	dLng := (b.Lng - a.Lng) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// pointInPolygon uses ray casting; venue-sized polygons are small enough to
+// treat latitude/longitude as planar.
+func pointInPolygon(p geoPoint, polygon []geoPoint) bool {
+	inside := false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		a, b := polygon[i], polygon[j]
+		if (a.Lat > p.Lat) != (b.Lat > p.Lat) &&
+			p.Lng < (b.Lng-a.Lng)*(p.Lat-a.Lat)/(b.Lat-a.Lat)+a.Lng {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// distanceToSegmentMeters projects the segment onto a local equirectangular
+// plane centered on p, which is accurate at venue scale.
+func distanceToSegmentMeters(p, a, b geoPoint) float64 {
+	scale := math.Cos(p.Lat * math.Pi / 180)
+	toPlane := func(q geoPoint) (float64, float64) {
+		x := (q.Lng - p.Lng) * math.Pi / 180 * earthRadiusMeters * scale
+		y := (q.Lat - p.Lat) * math.Pi / 180 * earthRadiusMeters
+		return x, y
+	}
+	ax, ay := toPlane(a)
+	bx, by := toPlane(b)
+
+	dx, dy := bx-ax, by-ay
+	t := 0.0
+	if lengthSq := dx*dx + dy*dy; lengthSq > 0 {
+		t = math.Max(0, math.Min(1, -(ax*dx+ay*dy)/lengthSq))
+	}
+	return math.Hypot(ax+t*dx, ay+t*dy)
+}
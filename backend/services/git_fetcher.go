@@ -0,0 +1,152 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// maxSnapshotCommits bounds how much history is inspected for late commits.
+const maxSnapshotCommits = 1000
+
+// GitCommit is one commit on the snapshot branch.
+type GitCommit struct {
+	SHA        string    `json:"sha"`
+	Author     string    `json:"author"`
+	AuthorDate time.Time `json:"author_date"`
+	CommitDate time.Time `json:"commit_date"`
+}
+
+// GitSnapshot describes the HEAD of a fetched repository.
+type GitSnapshot struct {
+	Branch    string
+	CommitSHA string
+	TreeHash  string
+	Commits   []GitCommit // Newest first
+}
+
+// GitFetcher retrieves the current HEAD of a repository and writes a tar.gz
+// archive of its tree. Any implementation will do; the exec fetcher accepts
+// whatever git accepts, including the path of a local bare repository.
+type GitFetcher interface {
+	Snapshot(ctx context.Context, repoURL string, archive io.Writer) (*GitSnapshot, error)
+}
+
+type execGitFetcher struct {
+	binary         string
+	allowProtocols string
+}
+
+// NewExecGitFetcher returns a fetcher that shells out to git. allowProtocols
+// is passed as GIT_ALLOW_PROTOCOL (e.g. "https" or "https:file") so teams
+// cannot make the server reach through other transports.
+func NewExecGitFetcher(binary string, allowProtocols string) (GitFetcher, error) {
+	path, err := exec.LookPath(binary)
+	if err != nil {
+		return nil, err
+	}
+	if allowProtocols == "" {
+		return nil, errors.New("no git protocols allowed")
+	}
+	return &execGitFetcher{binary: path, allowProtocols: allowProtocols}, nil
+}
+
+func (f *execGitFetcher) Snapshot(ctx context.Context, repoURL string, archive io.Writer) (*GitSnapshot, error) {
+	dir, err := os.MkdirTemp("", "repo-snapshot-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	// A bare single-branch clone is enough for HEAD, its history and an archive
+	if _, err := f.run(ctx, "", nil, "clone", "--bare", "--quiet", "--single-branch", "--no-tags", "--", repoURL, dir); err != nil {
+		return nil, err
+	}
+
+	snapshot := &GitSnapshot{}
+	if branch, err := f.run(ctx, dir, nil, "symbolic-ref", "--short", "HEAD"); err == nil {
+		snapshot.Branch = branch
+	}
+	if snapshot.CommitSHA, err = f.run(ctx, dir, nil, "rev-parse", "--verify", "HEAD^{commit}"); err != nil {
+		return nil, err
+	}
+	if snapshot.TreeHash, err = f.run(ctx, dir, nil, "rev-parse", "--verify", "HEAD^{tree}"); err != nil {
+		return nil, err
+	}
+
+	log, err := f.run(ctx, dir, nil, "log", fmt.Sprintf("--max-count=%d", maxSnapshotCommits), "--format=%H%x1f%an%x1f%aI%x1f%cI", snapshot.CommitSHA)
+	if err != nil {
+		return nil, err
+	}
+	if snapshot.Commits, err = parseGitLog(log); err != nil {
+		return nil, err
+	}
+
+	if _, err := f.run(ctx, dir, archive, "archive", "--format=tar.gz", snapshot.CommitSHA); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// run executes git and returns its trimmed output, or streams it to stdout
+// when given.
+func (f *execGitFetcher) run(ctx context.Context, dir string, stdout io.Writer, args ...string) (string, error) {
+	command := args[0]
+	if dir != "" {
+		args = append([]string{"--git-dir", dir}, args...)
+	}
+	cmd := exec.CommandContext(ctx, f.binary, args...)
+	cmd.Env = append(os.Environ(),
+		"GIT_TERMINAL_PROMPT=0",
+		"GIT_ALLOW_PROTOCOL="+f.allowProtocols,
+		"GIT_CONFIG_NOSYSTEM=1",
+	)
+
+	var out, stderr bytes.Buffer
+	cmd.Stdout = &out
+	if stdout != nil {
+		cmd.Stdout = stdout
+	}
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("git %s: %s", command, msg)
+		}
+		return "", fmt.Errorf("git %s: %w", command, err)
+	}
+	return strings.TrimSpace(out.String()), nil
+}
+
+func parseGitLog(log string) ([]GitCommit, error) {
+	var commits []GitCommit
+	for _, line := range strings.Split(log, "\n") {
+		if line == "" {
+			continue
+		}
+		fields := strings.Split(line, "\x1f")
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("unexpected git log line %q", line)
+		}
+		authorDate, err := time.Parse(time.RFC3339, fields[2])
+		if err != nil {
+			return nil, err
+		}
+		commitDate, err := time.Parse(time.RFC3339, fields[3])
+		if err != nil {
+			return nil, err
+		}
+		commits = append(commits, GitCommit{
+			SHA:        fields[0],
+			Author:     fields[1],
+			AuthorDate: authorDate,
+			CommitDate: commitDate,
+		})
+	}
+	return commits, nil
+}
@@ -0,0 +1,418 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"hackathon-platform/backend/models"
+	"hackathon-platform/backend/repositories"
+	"sort"
+	"time"
+)
+
+const defaultJudgesPerSubmission = 3
+
+// JudgeAssignmentService distributes approved submissions over the event's
+// judges and tracks what each judge still has to review.
+type JudgeAssignmentService interface {
+	AssignJudges(eventID uint, req *AssignJudgesRequest) (*JudgeAssignmentResult, error)
+	ReassignJudge(eventID uint, req *ReassignJudgeRequest) (*JudgeAssignmentResult, error)
+	ListAssignments(eventID uint, judgeAddress string) ([]JudgeAssignmentView, error)
+	GetQueue(eventID uint, judgeAddress string) ([]JudgeQueueItem, error)
+}
+
+type judgeAssignmentService struct {
+	assignmentRepo repositories.JudgeAssignmentRepository
+	eventJudgeRepo repositories.EventJudgeRepository
+	eventRepo      repositories.EventRepository
+	submissionRepo repositories.SubmissionRepository
+	teamRepo       repositories.TeamRepository
+	voteRepo       repositories.VoteRepository
+	rubricRepo     repositories.RubricRepository
+	conflictRepo   repositories.JudgeConflictRepository
+}
+
+func NewJudgeAssignmentService(
+	assignmentRepo repositories.JudgeAssignmentRepository,
+	eventJudgeRepo repositories.EventJudgeRepository,
+	eventRepo repositories.EventRepository,
+	submissionRepo repositories.SubmissionRepository,
+	teamRepo repositories.TeamRepository,
+	voteRepo repositories.VoteRepository,
+	rubricRepo repositories.RubricRepository,
+	conflictRepo repositories.JudgeConflictRepository,
+) JudgeAssignmentService {
+	return &judgeAssignmentService{
+		assignmentRepo: assignmentRepo,
+		eventJudgeRepo: eventJudgeRepo,
+		eventRepo:      eventRepo,
+		submissionRepo: submissionRepo,
+		teamRepo:       teamRepo,
+		voteRepo:       voteRepo,
+		rubricRepo:     rubricRepo,
+		conflictRepo:   conflictRepo,
+	}
+}
+
+// AssignJudgesRequest tops up every approved submission to the given number
+// of judges.
+type AssignJudgesRequest struct {
+	OrganizerAddress    string `json:"organizer_address" binding:"required"`
+	JudgesPerSubmission int    `json:"judges_per_submission"` // Defaults to 3
+}
+
+// ReassignJudgeRequest releases a judge's unfinished assignments and hands
+// them to other judges.
+type ReassignJudgeRequest struct {
+	OrganizerAddress string `json:"organizer_address" binding:"required"`
+	JudgeAddress     string `json:"judge_address" binding:"required"`
+}
+
+// JudgeAssignmentResult lists new assignments and the submissions that could
+// not get enough judges without exceeding limits or conflicts of interest.
+type JudgeAssignmentResult struct {
+	Assignments  []models.JudgeAssignment `json:"assignments"`
+	Released     []uint                   `json:"released,omitempty"`
+	Understaffed []uint                   `json:"understaffed"`
+}
+
+// JudgeAssignmentView is an assignment with whether the judge has voted on
+// or scored the submission.
+type JudgeAssignmentView struct {
+	models.JudgeAssignment
+	Completed bool `json:"completed"`
+}
+
+// JudgeQueueItem is a submission the judge still has to review.
+type JudgeQueueItem struct {
+	AssignmentID uint              `json:"assignment_id"`
+	AssignedAt   time.Time         `json:"assigned_at"`
+	Submission   models.Submission `json:"submission"`
+}
+
+// judgeSlot is a submission that needs more judges.
+type judgeSlot struct {
+	submissionID uint
+	team         *models.Team
+	assigned     map[string]bool // Judges already assigned, or excluded
+	conflicts    map[string]bool // Judges who declared a conflict with the team
+	need         int
+}
+
+func (s *judgeAssignmentService) AssignJudges(eventID uint, req *AssignJudgesRequest) (*JudgeAssignmentResult, error) {
+	if err := s.checkOrganizer(eventID, req.OrganizerAddress); err != nil {
+		return nil, err
+	}
+	perSubmission := req.JudgesPerSubmission
+	if perSubmission == 0 {
+		perSubmission = defaultJudgesPerSubmission
+	}
+	if perSubmission < 1 {
+		return nil, errors.New("judges per submission must be at least 1")
+	}
+
+	judges, err := s.eventJudgeRepo.ListByEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+	if len(judges) == 0 {
+		return nil, errors.New("event has no judges")
+	}
+	active, err := s.assignmentRepo.ListActiveByEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+	load, assigned := assignmentLoad(active)
+	declared, err := s.declaredConflicts(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	submissions, err := s.submissionRepo.GetByEventID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	var slots []judgeSlot
+	for _, submission := range submissions {
+		if submission.Status != models.SubmissionStatusApproved {
+			continue
+		}
+		need := perSubmission - len(assigned[submission.ID])
+		if need <= 0 {
+			continue
+		}
+		team, err := s.teamRepo.GetByID(submission.TeamID)
+		if err != nil {
+			return nil, err
+		}
+		slots = append(slots, judgeSlot{submissionID: submission.ID, team: team, assigned: assigned[submission.ID], conflicts: declared[team.ID], need: need})
+	}
+
+	picks, understaffed := planJudgeAssignments(slots, judges, load)
+	result := &JudgeAssignmentResult{Assignments: []models.JudgeAssignment{}, Understaffed: understaffed}
+	for _, slot := range slots {
+		for _, judge := range picks[slot.submissionID] {
+			result.Assignments = append(result.Assignments, models.JudgeAssignment{
+				EventID:      eventID,
+				SubmissionID: slot.submissionID,
+				JudgeAddress: judge,
+				Status:       models.JudgeAssignmentActive,
+				AssignedBy:   req.OrganizerAddress,
+			})
+		}
+	}
+	if err := s.assignmentRepo.CreateBatch(result.Assignments); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ReassignJudge handles a judge dropping out: assignments the judge has not
+// completed are released and each goes to the least loaded eligible judge.
+func (s *judgeAssignmentService) ReassignJudge(eventID uint, req *ReassignJudgeRequest) (*JudgeAssignmentResult, error) {
+	if err := s.checkOrganizer(eventID, req.OrganizerAddress); err != nil {
+		return nil, err
+	}
+	address := normalizeAddress(req.JudgeAddress)
+
+	judgeAssignments, err := s.assignmentRepo.ListActiveByJudge(eventID, address)
+	if err != nil {
+		return nil, err
+	}
+	completed, err := s.completed(eventID)
+	if err != nil {
+		return nil, err
+	}
+	var open []models.JudgeAssignment
+	for _, assignment := range judgeAssignments {
+		if !completed[judgeKey(assignment.SubmissionID, address)] {
+			open = append(open, assignment)
+		}
+	}
+	result := &JudgeAssignmentResult{Assignments: []models.JudgeAssignment{}, Released: []uint{}, Understaffed: []uint{}}
+	if len(open) == 0 {
+		return result, nil
+	}
+
+	judges, err := s.eventJudgeRepo.ListByEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+	active, err := s.assignmentRepo.ListActiveByEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+	load, assigned := assignmentLoad(active)
+	declared, err := s.declaredConflicts(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	slots := make([]judgeSlot, 0, len(open))
+	replaced := make(map[uint]uint, len(open))
+	for _, assignment := range open {
+		submission, err := s.submissionRepo.GetByID(assignment.SubmissionID)
+		if err != nil {
+			return nil, err
+		}
+		// The departing judge stays in the assigned set so it is not picked again
+		slots = append(slots, judgeSlot{submissionID: submission.ID, team: &submission.Team, assigned: assigned[submission.ID], conflicts: declared[submission.TeamID], need: 1})
+		replaced[submission.ID] = assignment.ID
+		result.Released = append(result.Released, assignment.ID)
+	}
+
+	picks, understaffed := planJudgeAssignments(slots, judges, load)
+	result.Understaffed = understaffed
+	for _, slot := range slots {
+		for _, judge := range picks[slot.submissionID] {
+			replacedID := replaced[slot.submissionID]
+			result.Assignments = append(result.Assignments, models.JudgeAssignment{
+				EventID:      eventID,
+				SubmissionID: slot.submissionID,
+				JudgeAddress: judge,
+				Status:       models.JudgeAssignmentActive,
+				AssignedBy:   req.OrganizerAddress,
+				ReplacedID:   &replacedID,
+			})
+		}
+	}
+
+	if err := s.assignmentRepo.Release(result.Released, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.assignmentRepo.CreateBatch(result.Assignments); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *judgeAssignmentService) ListAssignments(eventID uint, judgeAddress string) ([]JudgeAssignmentView, error) {
+	assignments, err := s.assignmentRepo.ListByEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+	completed, err := s.completed(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	judge := normalizeAddress(judgeAddress)
+	views := []JudgeAssignmentView{}
+	for _, assignment := range assignments {
+		if judge != "" && assignment.JudgeAddress != judge {
+			continue
+		}
+		views = append(views, JudgeAssignmentView{
+			JudgeAssignment: assignment,
+			Completed:       completed[judgeKey(assignment.SubmissionID, assignment.JudgeAddress)],
+		})
+	}
+	return views, nil
+}
+
+// GetQueue returns the judge's active assignments not yet voted on or scored.
+func (s *judgeAssignmentService) GetQueue(eventID uint, judgeAddress string) ([]JudgeQueueItem, error) {
+	address := normalizeAddress(judgeAddress)
+	assignments, err := s.assignmentRepo.ListActiveByJudge(eventID, address)
+	if err != nil {
+		return nil, err
+	}
+	completed, err := s.completed(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := []JudgeQueueItem{}
+	for _, assignment := range assignments {
+		if completed[judgeKey(assignment.SubmissionID, address)] {
+			continue
+		}
+		submission, err := s.submissionRepo.GetByID(assignment.SubmissionID)
+		if err != nil {
+			return nil, err
+		}
+		queue = append(queue, JudgeQueueItem{
+			AssignmentID: assignment.ID,
+			AssignedAt:   assignment.CreatedAt,
+			Submission:   *submission,
+		})
+	}
+	return queue, nil
+}
+
+func (s *judgeAssignmentService) checkOrganizer(eventID uint, organizerAddress string) error {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		return errors.New("event not found")
+	}
+	if normalizeAddress(event.OrganizerAddress) != normalizeAddress(organizerAddress) {
+		return errors.New("only the organizer can assign judges")
+	}
+	return nil
+}
+
+// completed returns the submission/judge pairs where the judge has cast a
+// judge vote or submitted a scorecard.
+func (s *judgeAssignmentService) completed(eventID uint) (map[string]bool, error) {
+	votes, err := s.voteRepo.GetByEventID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	scores, err := s.rubricRepo.ListScoresByEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	done := make(map[string]bool)
+	for _, vote := range votes {
+		if vote.VoterType == models.VoterTypeJudge {
+			done[judgeKey(vote.SubmissionID, vote.VoterAddress)] = true
+		}
+	}
+	for _, score := range scores {
+		done[judgeKey(score.SubmissionID, score.JudgeAddress)] = true
+	}
+	return done, nil
+}
+
+// assignmentLoad counts active assignments per judge and the judges already
+// assigned to each submission.
+func assignmentLoad(active []models.JudgeAssignment) (map[string]int, map[uint]map[string]bool) {
+	load := make(map[string]int)
+	assigned := make(map[uint]map[string]bool)
+	for _, assignment := range active {
+		load[assignment.JudgeAddress]++
+		if assigned[assignment.SubmissionID] == nil {
+			assigned[assignment.SubmissionID] = make(map[string]bool)
+		}
+		assigned[assignment.SubmissionID][assignment.JudgeAddress] = true
+	}
+	return load, assigned
+}
+
+// planJudgeAssignments fills the slots with the fewest judges first, each
+// time picking the least loaded judges who are not at their MaxVotes limit,
+// not already assigned, not on the submission's team and without a declared
+// conflict with it. load is updated
+// with the picks.
+func planJudgeAssignments(slots []judgeSlot, judges []models.EventJudge, load map[string]int) (map[uint][]string, []uint) {
+	order := make([]int, len(slots))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		sa, sb := slots[order[a]], slots[order[b]]
+		if len(sa.assigned) != len(sb.assigned) {
+			return len(sa.assigned) < len(sb.assigned)
+		}
+		return sa.submissionID < sb.submissionID
+	})
+
+	picks := make(map[uint][]string, len(slots))
+	understaffed := []uint{}
+	for _, i := range order {
+		slot := slots[i]
+		var candidates []string
+		for _, judge := range judges {
+			address := normalizeAddress(judge.Address)
+			if slot.assigned[address] || judge.Weight <= 0 {
+				continue
+			}
+			if judge.MaxVotes > 0 && load[address] >= int(judge.MaxVotes) {
+				continue
+			}
+			if slot.conflicts[address] || (slot.team != nil && isTeamMember(slot.team, address)) {
+				continue
+			}
+			candidates = append(candidates, address)
+		}
+		sort.SliceStable(candidates, func(a, b int) bool {
+			if load[candidates[a]] != load[candidates[b]] {
+				return load[candidates[a]] < load[candidates[b]]
+			}
+			return candidates[a] < candidates[b]
+		})
+
+		need := slot.need
+		if len(candidates) < need {
+			understaffed = append(understaffed, slot.submissionID)
+			need = len(candidates)
+		}
+		for _, judge := range candidates[:need] {
+			load[judge]++
+			picks[slot.submissionID] = append(picks[slot.submissionID], judge)
+		}
+	}
+	return picks, understaffed
+}
+
+func (s *judgeAssignmentService) declaredConflicts(eventID uint) (map[uint]map[string]bool, error) {
+	conflicts, err := s.conflictRepo.ListByEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+	return declaredConflicts(conflicts), nil
+}
+
+func judgeKey(submissionID uint, judgeAddress string) string {
+	return fmt.Sprintf("%d:%s", submissionID, normalizeAddress(judgeAddress))
+}
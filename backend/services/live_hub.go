@@ -0,0 +1,158 @@
+package services
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// LiveTopic identifies a kind of live update published per event.
+type LiveTopic string
+
+const (
+	LiveTopicCheckIns      LiveTopic = "checkins"
+	LiveTopicRegistrations LiveTopic = "registrations"
+	LiveTopicSubmissions   LiveTopic = "submissions"
+	LiveTopicVotes         LiveTopic = "votes"
+)
+
+// AllLiveTopics lists every topic a subscriber may ask for.
+var AllLiveTopics = []LiveTopic{
+	LiveTopicCheckIns,
+	LiveTopicRegistrations,
+	LiveTopicSubmissions,
+	LiveTopicVotes,
+}
+
+// maxLiveSubscribersPerEvent caps concurrent stream connections per event.
+const maxLiveSubscribersPerEvent = 1000
+
+// LiveUpdate is a snapshot of one topic for one event.
+type LiveUpdate struct {
+	EventID uint        `json:"event_id"`
+	Topic   LiveTopic   `json:"topic"`
+	Data    interface{} `json:"data"`
+	At      time.Time   `json:"at"`
+	// Restricted updates are only delivered in full to privileged subscribers
+	// (e.g. vote tallies during a results freeze).
+	Restricted bool `json:"-"`
+}
+
+// LiveSubscription buffers updates for one stream connection. It keeps only
+// the latest update per topic, so a slow client never blocks publishers and
+// never holds more than one pending snapshot per topic.
+type LiveSubscription struct {
+	eventID    uint
+	topics     map[LiveTopic]bool
+	privileged bool
+
+	mu      sync.Mutex
+	pending map[LiveTopic]LiveUpdate
+	order   []LiveTopic
+	notify  chan struct{}
+}
+
+// Notify is signalled whenever new updates are pending.
+func (s *LiveSubscription) Notify() <-chan struct{} {
+	return s.notify
+}
+
+// Drain returns and clears the pending updates in arrival order.
+func (s *LiveSubscription) Drain() []LiveUpdate {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	updates := make([]LiveUpdate, 0, len(s.order))
+	for _, topic := range s.order {
+		updates = append(updates, s.pending[topic])
+	}
+	s.pending = make(map[LiveTopic]LiveUpdate)
+	s.order = s.order[:0]
+	return updates
+}
+
+// Offer queues an update for this subscription, replacing any pending update
+// for the same topic.
+func (s *LiveSubscription) Offer(update LiveUpdate) {
+	if !s.topics[update.Topic] {
+		return
+	}
+	if update.Restricted && !s.privileged {
+		update.Data = map[string]interface{}{"hidden": true}
+	}
+
+	s.mu.Lock()
+	if _, queued := s.pending[update.Topic]; !queued {
+		s.order = append(s.order, update.Topic)
+	}
+	s.pending[update.Topic] = update
+	s.mu.Unlock()
+
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// LiveHub fans out live updates to per-event subscribers.
+type LiveHub struct {
+	mu   sync.RWMutex
+	subs map[uint]map[*LiveSubscription]struct{}
+}
+
+func NewLiveHub() *LiveHub {
+	return &LiveHub{subs: make(map[uint]map[*LiveSubscription]struct{})}
+}
+
+// Subscribe registers a subscriber for the given topics of an event. An empty
+// topic list subscribes to every topic.
+func (h *LiveHub) Subscribe(eventID uint, topics []LiveTopic, privileged bool) (*LiveSubscription, error) {
+	if len(topics) == 0 {
+		topics = AllLiveTopics
+	}
+	sub := &LiveSubscription{
+		eventID:    eventID,
+		topics:     make(map[LiveTopic]bool, len(topics)),
+		privileged: privileged,
+		pending:    make(map[LiveTopic]LiveUpdate),
+		notify:     make(chan struct{}, 1),
+	}
+	for _, topic := range topics {
+		sub.topics[topic] = true
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.subs[eventID]) >= maxLiveSubscribersPerEvent {
+		return nil, errors.New("too many live subscribers for this event")
+	}
+	if h.subs[eventID] == nil {
+		h.subs[eventID] = make(map[*LiveSubscription]struct{})
+	}
+	h.subs[eventID][sub] = struct{}{}
+	return sub, nil
+}
+
+func (h *LiveHub) Unsubscribe(sub *LiveSubscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.subs[sub.eventID], sub)
+	if len(h.subs[sub.eventID]) == 0 {
+		delete(h.subs, sub.eventID)
+	}
+}
+
+// HasSubscribers reports whether anyone is listening to the event.
+func (h *LiveHub) HasSubscribers(eventID uint) bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.subs[eventID]) > 0
+}
+
+func (h *LiveHub) Publish(update LiveUpdate) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for sub := range h.subs[update.EventID] {
+		sub.Offer(update)
+	}
+}
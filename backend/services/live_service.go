@@ -0,0 +1,171 @@
+package services
+
+import (
+	"errors"
+	"hackathon-platform/backend/repositories"
+	"sync"
+	"time"
+)
+
+// liveDebounce batches bursts of changes into a single snapshot per topic.
+const liveDebounce = 500 * time.Millisecond
+
+// LiveNotifier is told when data behind a live topic changes.
+type LiveNotifier interface {
+	Changed(eventID uint, topic LiveTopic)
+}
+
+// LiveService builds per-event snapshots and streams them to subscribers.
+type LiveService interface {
+	LiveNotifier
+	Subscribe(eventID uint, topics []LiveTopic, viewer *ViewerProof) (*LiveSubscription, error)
+	Unsubscribe(sub *LiveSubscription)
+}
+
+type liveKey struct {
+	eventID uint
+	topic   LiveTopic
+}
+
+type liveService struct {
+	hub              *LiveHub
+	eventRepo        repositories.EventRepository
+	checkInRepo      repositories.CheckInRepository
+	registrationRepo repositories.RegistrationRepository
+	submissionRepo   repositories.SubmissionRepository
+	votes            VoteService
+
+	mu        sync.Mutex
+	scheduled map[liveKey]bool
+}
+
+func NewLiveService(
+	hub *LiveHub,
+	eventRepo repositories.EventRepository,
+	checkInRepo repositories.CheckInRepository,
+	registrationRepo repositories.RegistrationRepository,
+	submissionRepo repositories.SubmissionRepository,
+	votes VoteService,
+) LiveService {
+	return &liveService{
+		hub:              hub,
+		eventRepo:        eventRepo,
+		checkInRepo:      checkInRepo,
+		registrationRepo: registrationRepo,
+		submissionRepo:   submissionRepo,
+		votes:            votes,
+		scheduled:        make(map[liveKey]bool),
+	}
+}
+
+// notifyLive is a nil-safe helper for services with an optional notifier.
+func notifyLive(live LiveNotifier, eventID uint, topic LiveTopic) {
+	if live != nil {
+		live.Changed(eventID, topic)
+	}
+}
+
+func (s *liveService) Subscribe(eventID uint, topics []LiveTopic, viewer *ViewerProof) (*LiveSubscription, error) {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+
+	privileged := isOrganizerViewer(event, viewer)
+	sub, err := s.hub.Subscribe(eventID, topics, privileged)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start every subscriber from the current state
+	for topic := range sub.topics {
+		update, err := s.snapshot(eventID, topic)
+		if err != nil {
+			continue
+		}
+		sub.Offer(*update)
+	}
+	return sub, nil
+}
+
+func (s *liveService) Unsubscribe(sub *LiveSubscription) {
+	s.hub.Unsubscribe(sub)
+}
+
+// Changed schedules a snapshot of the topic. Bursts of changes within the
+// debounce window produce a single update, so heavy write traffic does not
+// translate into one query per write.
+func (s *liveService) Changed(eventID uint, topic LiveTopic) {
+	if !s.hub.HasSubscribers(eventID) {
+		return
+	}
+
+	key := liveKey{eventID: eventID, topic: topic}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.scheduled[key] {
+		return
+	}
+	s.scheduled[key] = true
+
+	time.AfterFunc(liveDebounce, func() {
+		s.mu.Lock()
+		delete(s.scheduled, key)
+		s.mu.Unlock()
+
+		update, err := s.snapshot(eventID, topic)
+		if err != nil {
+			return
+		}
+		s.hub.Publish(*update)
+	})
+}
+
+func (s *liveService) snapshot(eventID uint, topic LiveTopic) (*LiveUpdate, error) {
+	update := &LiveUpdate{EventID: eventID, Topic: topic, At: time.Now()}
+
+	switch topic {
+	case LiveTopicCheckIns:
+		count, err := s.checkInRepo.CountByEventID(eventID)
+		if err != nil {
+			return nil, err
+		}
+		update.Data = map[string]interface{}{"count": count}
+	case LiveTopicRegistrations:
+		registrations, err := s.registrationRepo.GetByEventID(eventID)
+		if err != nil {
+			return nil, err
+		}
+		byStatus := make(map[string]int)
+		for _, registration := range registrations {
+			byStatus[string(registration.Status)]++
+		}
+		update.Data = map[string]interface{}{"total": len(registrations), "by_status": byStatus}
+	case LiveTopicSubmissions:
+		submissions, err := s.submissionRepo.GetByEventID(eventID)
+		if err != nil {
+			return nil, err
+		}
+		byStatus := make(map[string]int)
+		for _, submission := range submissions {
+			byStatus[string(submission.Status)]++
+		}
+		update.Data = map[string]interface{}{"total": len(submissions), "by_status": byStatus}
+	case LiveTopicVotes:
+		event, err := s.eventRepo.GetByID(eventID)
+		if err != nil {
+			return nil, err
+		}
+		// The same summary GET /votes/event/:eventId/summary returns
+		summaries, err := s.votes.BuildEventSummary(event)
+		if err != nil {
+			return nil, err
+		}
+		update.Data = summaries
+		update.Restricted = talliesHidden(event)
+	default:
+		return nil, errors.New("unknown live topic")
+	}
+
+	return update, nil
+}
@@ -0,0 +1,125 @@
+package services
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+// Merkle trees follow RFC 6962: leaves and interior nodes are hashed with
+// distinct prefixes so a leaf can never be passed off as a node, and trees
+// of any size are split at the largest power of two below the leaf count.
+const (
+	merkleLeafPrefix = 0x00
+	merkleNodePrefix = 0x01
+)
+
+func merkleLeafHash(data []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{merkleLeafPrefix})
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+func merkleNodeHash(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{merkleNodePrefix})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// largestPowerOfTwoBelow returns the largest power of two strictly less than n (n > 1).
+func largestPowerOfTwoBelow(n int) int {
+	k := 1
+	for k<<1 < n {
+		k <<= 1
+	}
+	return k
+}
+
+// merkleRoot computes the root over already-hashed leaves.
+func merkleRoot(leaves [][]byte) []byte {
+	switch len(leaves) {
+	case 0:
+		sum := sha256.Sum256(nil)
+		return sum[:]
+	case 1:
+		return leaves[0]
+	}
+	k := largestPowerOfTwoBelow(len(leaves))
+	return merkleNodeHash(merkleRoot(leaves[:k]), merkleRoot(leaves[k:]))
+}
+
+// merkleInclusionProof returns the audit path for leaves[index], ordered from
+// the leaf up to the root.
+func merkleInclusionProof(leaves [][]byte, index int) [][]byte {
+	if len(leaves) <= 1 {
+		return [][]byte{}
+	}
+	k := largestPowerOfTwoBelow(len(leaves))
+	if index < k {
+		return append(merkleInclusionProof(leaves[:k], index), merkleRoot(leaves[k:]))
+	}
+	return append(merkleInclusionProof(leaves[k:], index-k), merkleRoot(leaves[:k]))
+}
+
+// verifyMerkleInclusion checks an audit path using the RFC 9162 §2.1.3.2
+// algorithm and reports whether it reproduces the expected root.
+func verifyMerkleInclusion(leafHash []byte, index, treeSize int, proof [][]byte, root []byte) bool {
+	if index < 0 || index >= treeSize {
+		return false
+	}
+
+	fn, sn := index, treeSize-1
+	r := leafHash
+	for _, p := range proof {
+		if sn == 0 {
+			return false
+		}
+		if fn&1 == 1 || fn == sn {
+			r = merkleNodeHash(p, r)
+			if fn&1 == 0 {
+				for fn&1 == 0 && fn != 0 {
+					fn >>= 1
+					sn >>= 1
+				}
+			}
+		} else {
+			r = merkleNodeHash(r, p)
+		}
+		fn >>= 1
+		sn >>= 1
+	}
+	return sn == 0 && bytes.Equal(r, root)
+}
+
+func encodeHashes(hashes [][]byte) []string {
+	out := make([]string, len(hashes))
+	for i, h := range hashes {
+		out[i] = hex.EncodeToString(h)
+	}
+	return out
+}
+
+func decodeHash(s string) ([]byte, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(strings.TrimSpace(s)), "0x"))
+	if err != nil || len(b) != sha256.Size {
+		return nil, errors.New("invalid hash: " + s)
+	}
+	return b, nil
+}
+
+func decodeHashes(values []string) ([][]byte, error) {
+	out := make([][]byte, len(values))
+	for i, v := range values {
+		b, err := decodeHash(v)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = b
+	}
+	return out, nil
+}
@@ -0,0 +1,72 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// PersonhoodVerifier decides whether a proof shows that an address belongs
+// to a unique human. Any implementation will do; the HTTP verifier delegates
+// to an external service such as a World ID or BrightID bridge.
+type PersonhoodVerifier interface {
+	Verify(ctx context.Context, address string, proof string) (bool, error)
+}
+
+type httpPersonhoodVerifier struct {
+	endpoint string
+	client   *http.Client
+}
+
+// personhoodCheck is the body posted to the verification endpoint.
+type personhoodCheck struct {
+	Address string `json:"address"`
+	Proof   string `json:"proof"`
+}
+
+// personhoodResult is the response expected from the verification endpoint.
+type personhoodResult struct {
+	Verified bool `json:"verified"`
+}
+
+// NewHTTPPersonhoodVerifier returns a verifier that posts
+// {"address", "proof"} to endpoint and expects {"verified": bool} back.
+func NewHTTPPersonhoodVerifier(endpoint string) (PersonhoodVerifier, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, errors.New("invalid personhood verifier URL")
+	}
+	return &httpPersonhoodVerifier{endpoint: u.String(), client: &http.Client{}}, nil
+}
+
+func (v *httpPersonhoodVerifier) Verify(ctx context.Context, address string, proof string) (bool, error) {
+	body, err := json.Marshal(personhoodCheck{Address: address, Proof: proof})
+	if err != nil {
+		return false, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("personhood verifier returned %s", resp.Status)
+	}
+
+	var result personhoodResult
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 1<<16)).Decode(&result); err != nil {
+		return false, err
+	}
+	return result.Verified, nil
+}
@@ -0,0 +1,272 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hackathon-platform/backend/models"
+	"hackathon-platform/backend/repositories"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	maxAllowlistUpload = 10000
+	personhoodTimeout  = 10 * time.Second
+)
+
+// PolicyError reports which public voter policy rejected a voter.
+type PolicyError struct {
+	Policy models.PublicVoterPolicy
+	Reason string
+}
+
+func (e *PolicyError) Error() string {
+	return fmt.Sprintf("public voter policy %q failed: %s", e.Policy, e.Reason)
+}
+
+// PublicVoterService decides who may cast public votes for an event and
+// manages the event allowlist.
+type PublicVoterService interface {
+	CheckVoter(event *models.Event, voterAddress string, proof string) error
+	CheckEligibility(eventID uint, voterAddress string, proof string) (*EligibilityReport, error)
+	ListAllowlist(eventID uint, organizerAddress string) ([]models.PublicVoterAllowlistEntry, error)
+	UploadAllowlist(eventID uint, req *UploadAllowlistRequest) (*AllowlistUploadResult, error)
+}
+
+type publicVoterService struct {
+	eventRepo        repositories.EventRepository
+	registrationRepo repositories.RegistrationRepository
+	checkInRepo      repositories.CheckInRepository
+	allowlistRepo    repositories.PublicVoterAllowlistRepository
+	personhood       PersonhoodVerifier
+}
+
+// NewPublicVoterService builds the service. personhood may be nil, in which
+// case events requiring proof of personhood reject every public voter.
+func NewPublicVoterService(
+	eventRepo repositories.EventRepository,
+	registrationRepo repositories.RegistrationRepository,
+	checkInRepo repositories.CheckInRepository,
+	allowlistRepo repositories.PublicVoterAllowlistRepository,
+	personhood PersonhoodVerifier,
+) PublicVoterService {
+	return &publicVoterService{
+		eventRepo:        eventRepo,
+		registrationRepo: registrationRepo,
+		checkInRepo:      checkInRepo,
+		allowlistRepo:    allowlistRepo,
+		personhood:       personhood,
+	}
+}
+
+// UploadAllowlistRequest carries the addresses an organizer allows to vote.
+type UploadAllowlistRequest struct {
+	OrganizerAddress string   `json:"organizer_address" binding:"required"`
+	Addresses        []string `json:"addresses"`
+	Replace          bool     `json:"replace"` // Drop the current allowlist instead of adding to it
+}
+
+// AllowlistUploadResult summarizes an allowlist upload.
+type AllowlistUploadResult struct {
+	EventID  uint `json:"event_id"`
+	Uploaded int  `json:"uploaded"` // Distinct addresses in the upload
+	Total    int  `json:"total"`    // Addresses on the allowlist afterwards
+}
+
+// PolicyCheck is the outcome of one public voter policy.
+type PolicyCheck struct {
+	Policy models.PublicVoterPolicy `json:"policy"`
+	Passed bool                     `json:"passed"`
+	Reason string                   `json:"reason,omitempty"`
+}
+
+// EligibilityReport lists every policy an event applies to a public voter.
+type EligibilityReport struct {
+	EventID      uint          `json:"event_id"`
+	VoterAddress string        `json:"voter_address"`
+	Eligible     bool          `json:"eligible"`
+	Checks       []PolicyCheck `json:"checks"`
+}
+
+// CheckVoter applies the event's policies in order and returns a
+// *PolicyError naming the first one the voter fails.
+func (s *publicVoterService) CheckVoter(event *models.Event, voterAddress string, proof string) error {
+	address := normalizeAddress(voterAddress)
+	for _, policy := range publicVoterPolicies(event) {
+		reason, err := s.evaluate(event, policy, address, proof)
+		if err != nil {
+			return err
+		}
+		if reason != "" {
+			return &PolicyError{Policy: policy, Reason: reason}
+		}
+	}
+	return nil
+}
+
+// CheckEligibility evaluates every policy so voters can see all they are
+// missing before they try to vote.
+func (s *publicVoterService) CheckEligibility(eventID uint, voterAddress string, proof string) (*EligibilityReport, error) {
+	address := normalizeAddress(voterAddress)
+	if address == "" {
+		return nil, errors.New("invalid voter address")
+	}
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &EligibilityReport{
+		EventID:      event.ID,
+		VoterAddress: address,
+		Eligible:     event.AllowPublicVoting,
+		Checks:       []PolicyCheck{},
+	}
+	for _, policy := range publicVoterPolicies(event) {
+		reason, err := s.evaluate(event, policy, address, proof)
+		if err != nil {
+			return nil, err
+		}
+		report.Checks = append(report.Checks, PolicyCheck{Policy: policy, Passed: reason == "", Reason: reason})
+		if reason != "" {
+			report.Eligible = false
+		}
+	}
+	return report, nil
+}
+
+// evaluate returns why address fails policy, or "" when it passes. Errors
+// are reserved for lookups that could not be completed.
+func (s *publicVoterService) evaluate(event *models.Event, policy models.PublicVoterPolicy, address string, proof string) (string, error) {
+	switch policy {
+	case models.PublicVoterPolicySBT:
+		registrations, err := s.registrationRepo.GetByEventID(event.ID)
+		if err != nil {
+			return "", err
+		}
+		for i := range registrations {
+			if registrations[i].Status == models.RegistrationStatusSBTMinted && isTeamMember(&registrations[i].Team, address) {
+				return "", nil
+			}
+		}
+		return "voter is not on a team holding a registration SBT for this event", nil
+	case models.PublicVoterPolicyCheckIn:
+		checkIns, err := s.checkInRepo.GetByEventAndUser(event.ID, address)
+		if err != nil {
+			return "", err
+		}
+		if len(checkIns) == 0 {
+			return "voter has not checked in to this event", nil
+		}
+		return "", nil
+	case models.PublicVoterPolicyAllowlist:
+		listed, err := s.allowlistRepo.Exists(event.ID, address)
+		if err != nil {
+			return "", err
+		}
+		if !listed {
+			return "voter is not on the event allowlist", nil
+		}
+		return "", nil
+	case models.PublicVoterPolicyPersonhood:
+		if s.personhood == nil {
+			return "no personhood verifier is configured", nil
+		}
+		if proof == "" {
+			return "a personhood proof is required in offchain_proof", nil
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), personhoodTimeout)
+		defer cancel()
+		verified, err := s.personhood.Verify(ctx, address, proof)
+		if err != nil {
+			return "personhood verification failed: " + err.Error(), nil
+		}
+		if !verified {
+			return "personhood proof was not accepted", nil
+		}
+		return "", nil
+	default:
+		return "", fmt.Errorf("unsupported public voter policy %q", policy)
+	}
+}
+
+// ListAllowlist returns the event allowlist. Only the organizer can read it.
+func (s *publicVoterService) ListAllowlist(eventID uint, organizerAddress string) ([]models.PublicVoterAllowlistEntry, error) {
+	if err := s.checkOrganizer(eventID, organizerAddress); err != nil {
+		return nil, err
+	}
+	return s.allowlistRepo.ListByEvent(eventID)
+}
+
+// UploadAllowlist adds addresses to the allowlist, or replaces it. The
+// whole upload is rejected when any address is malformed.
+func (s *publicVoterService) UploadAllowlist(eventID uint, req *UploadAllowlistRequest) (*AllowlistUploadResult, error) {
+	if err := s.checkOrganizer(eventID, req.OrganizerAddress); err != nil {
+		return nil, err
+	}
+	if len(req.Addresses) > maxAllowlistUpload {
+		return nil, fmt.Errorf("an allowlist upload can contain at most %d addresses", maxAllowlistUpload)
+	}
+
+	addresses := make([]string, 0, len(req.Addresses))
+	seen := make(map[string]bool, len(req.Addresses))
+	for _, raw := range req.Addresses {
+		if !common.IsHexAddress(raw) {
+			return nil, fmt.Errorf("invalid allowlist address %q", raw)
+		}
+		address := normalizeAddress(raw)
+		if seen[address] {
+			continue
+		}
+		seen[address] = true
+		addresses = append(addresses, address)
+	}
+
+	var err error
+	if req.Replace {
+		err = s.allowlistRepo.Replace(eventID, addresses)
+	} else {
+		err = s.allowlistRepo.Add(eventID, addresses)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.allowlistRepo.ListByEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+	return &AllowlistUploadResult{EventID: eventID, Uploaded: len(addresses), Total: len(entries)}, nil
+}
+
+func (s *publicVoterService) checkOrganizer(eventID uint, organizerAddress string) error {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		return errors.New("event not found")
+	}
+	if normalizeAddress(event.OrganizerAddress) != normalizeAddress(organizerAddress) {
+		return errors.New("only the organizer can manage the public voter allowlist")
+	}
+	return nil
+}
+
+// publicVoterPolicies lists the policies an event requires of public
+// voters, cheapest first so the verifier is only called when needed.
+func publicVoterPolicies(event *models.Event) []models.PublicVoterPolicy {
+	var policies []models.PublicVoterPolicy
+	if event.PublicRequireAllowlist {
+		policies = append(policies, models.PublicVoterPolicyAllowlist)
+	}
+	if event.PublicRequireCheckIn {
+		policies = append(policies, models.PublicVoterPolicyCheckIn)
+	}
+	if event.PublicRequireSBT {
+		policies = append(policies, models.PublicVoterPolicySBT)
+	}
+	if event.PublicRequirePersonhood {
+		policies = append(policies, models.PublicVoterPolicyPersonhood)
+	}
+	return policies
+}
@@ -21,17 +21,20 @@ type registrationService struct {
 	registrationRepo repositories.RegistrationRepository
 	teamRepo         repositories.TeamRepository
 	eventRepo       repositories.EventRepository
+	live             LiveNotifier
 }
 
 func NewRegistrationService(
 	registrationRepo repositories.RegistrationRepository,
 	teamRepo repositories.TeamRepository,
 	eventRepo repositories.EventRepository,
+	live LiveNotifier,
 ) RegistrationService {
 	return &registrationService{
 		registrationRepo: registrationRepo,
 		teamRepo:         teamRepo,
 		eventRepo:        eventRepo,
+		live:             live,
 	}
 }
 
@@ -84,6 +87,7 @@ func (s *registrationService) CreateRegistration(req *CreateRegistrationRequest)
 		return nil, err
 	}
 
+	notifyLive(s.live, registration.EventID, LiveTopicRegistrations)
 	return registration, nil
 }
 
@@ -121,6 +125,7 @@ func (s *registrationService) ApproveRegistration(id uint, organizerAddress stri
 		return nil, err
 	}
 
+	notifyLive(s.live, registration.EventID, LiveTopicRegistrations)
 	return registration, nil
 }
 
@@ -146,6 +151,7 @@ func (s *registrationService) RejectRegistration(id uint, organizerAddress strin
 		return nil, err
 	}
 
+	notifyLive(s.live, registration.EventID, LiveTopicRegistrations)
 	return registration, nil
 }
 
@@ -167,10 +173,20 @@ func (s *registrationService) UpdateSBTStatus(id uint, tokenID uint64, txHash st
 		return nil, err
 	}
 
+	notifyLive(s.live, registration.EventID, LiveTopicRegistrations)
 	return registration, nil
 }
 
 func (s *registrationService) DeleteRegistration(id uint) error {
-	return s.registrationRepo.Delete(id)
+	registration, err := s.registrationRepo.GetByID(id)
+	if err != nil {
+		return err
+	}
+	if err := s.registrationRepo.Delete(id); err != nil {
+		return err
+	}
+
+	notifyLive(s.live, registration.EventID, LiveTopicRegistrations)
+	return nil
 }
 
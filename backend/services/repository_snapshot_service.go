@@ -0,0 +1,260 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"hackathon-platform/backend/models"
+	"hackathon-platform/backend/repositories"
+	"hackathon-platform/backend/storage"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	maxSnapshotAttempts = 3
+	snapshotTimeout     = 5 * time.Minute
+)
+
+var ErrSnapshotTooLarge = errors.New("repository archive exceeds the size limit")
+
+type RepositorySnapshotService interface {
+	SnapshotSubmission(submissionID uint, organizerAddress string) (*models.RepositorySnapshot, error)
+	ListSnapshots(submissionID uint) ([]models.RepositorySnapshot, error)
+	OpenArchive(submissionID uint, snapshotID uint) (io.ReadCloser, *models.RepositorySnapshot, error)
+	SnapshotDue(now time.Time)
+}
+
+type repositorySnapshotService struct {
+	snapshotRepo   repositories.RepositorySnapshotRepository
+	submissionRepo repositories.SubmissionRepository
+	eventRepo      repositories.EventRepository
+	extensionRepo  repositories.SubmissionExtensionRepository
+	store          storage.BlobStore
+	fetcher        GitFetcher
+	maxBytes       int64
+}
+
+// NewRepositorySnapshotService builds the snapshot service. fetcher may be
+// nil, in which case snapshots cannot be taken but existing ones are served.
+func NewRepositorySnapshotService(
+	snapshotRepo repositories.RepositorySnapshotRepository,
+	submissionRepo repositories.SubmissionRepository,
+	eventRepo repositories.EventRepository,
+	extensionRepo repositories.SubmissionExtensionRepository,
+	store storage.BlobStore,
+	fetcher GitFetcher,
+	maxBytes int64,
+) RepositorySnapshotService {
+	return &repositorySnapshotService{
+		snapshotRepo:   snapshotRepo,
+		submissionRepo: submissionRepo,
+		eventRepo:      eventRepo,
+		extensionRepo:  extensionRepo,
+		store:          store,
+		fetcher:        fetcher,
+		maxBytes:       maxBytes,
+	}
+}
+
+// SnapshotSubmission takes a snapshot right away, e.g. to retry a failed
+// scheduled one. Failed attempts are recorded and returned as well.
+func (s *repositorySnapshotService) SnapshotSubmission(submissionID uint, organizerAddress string) (*models.RepositorySnapshot, error) {
+	submission, err := s.submissionRepo.GetByID(submissionID)
+	if err != nil {
+		return nil, err
+	}
+	event, err := s.eventRepo.GetByID(submission.EventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+	if normalizeAddress(event.OrganizerAddress) != normalizeAddress(organizerAddress) {
+		return nil, errors.New("only the organizer can snapshot repositories")
+	}
+	if strings.TrimSpace(submission.GithubRepo) == "" {
+		return nil, errors.New("submission has no repository")
+	}
+	if s.fetcher == nil {
+		return nil, errors.New("repository snapshots are not configured")
+	}
+
+	return s.snapshot(submission, event, organizerAddress)
+}
+
+func (s *repositorySnapshotService) ListSnapshots(submissionID uint) ([]models.RepositorySnapshot, error) {
+	if _, err := s.submissionRepo.GetByID(submissionID); err != nil {
+		return nil, err
+	}
+	return s.snapshotRepo.ListBySubmission(submissionID)
+}
+
+func (s *repositorySnapshotService) OpenArchive(submissionID uint, snapshotID uint) (io.ReadCloser, *models.RepositorySnapshot, error) {
+	snapshot, err := s.snapshotRepo.GetByID(snapshotID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if snapshot.SubmissionID != submissionID {
+		return nil, nil, errors.New("snapshot does not belong to this submission")
+	}
+	if snapshot.ArchiveCID == "" {
+		return nil, nil, storage.ErrBlobNotFound
+	}
+
+	content, err := s.store.Open(snapshot.ArchiveCID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return content, snapshot, nil
+}
+
+// SnapshotDue is run periodically. Once a team's submission window has
+// closed (including extension and grace), its repository is snapshotted,
+// retrying failed attempts a few times.
+func (s *repositorySnapshotService) SnapshotDue(now time.Time) {
+	if s.fetcher == nil {
+		return
+	}
+
+	events, err := s.eventRepo.ListSubmissionClosedBefore(now)
+	if err != nil {
+		return
+	}
+	for i := range events {
+		event := &events[i]
+		submissions, err := s.submissionRepo.GetByEventID(event.ID)
+		if err != nil {
+			continue
+		}
+		for j := range submissions {
+			submission := &submissions[j]
+			if !submissionFinalized(submission.Status) || strings.TrimSpace(submission.GithubRepo) == "" {
+				continue
+			}
+
+			extension, _ := s.extensionRepo.GetActive(event.ID, submission.TeamID)
+			if cutoff, ok := teamCutoff(event, extension); !ok || now.Before(cutoff) {
+				continue
+			}
+			if done, err := s.snapshotRepo.HasCompleted(submission.ID); err != nil || done {
+				continue
+			}
+			if failed, err := s.snapshotRepo.CountFailed(submission.ID); err != nil || failed >= maxSnapshotAttempts {
+				continue
+			}
+			s.snapshot(submission, event, "")
+		}
+	}
+}
+
+// snapshot fetches the repository, stores its archive and records the
+// result. Commits authored after the team's on-time deadline are flagged.
+func (s *repositorySnapshotService) snapshot(submission *models.Submission, event *models.Event, triggeredBy string) (*models.RepositorySnapshot, error) {
+	extension, _ := s.extensionRepo.GetActive(event.ID, submission.TeamID)
+	record := &models.RepositorySnapshot{
+		SubmissionID: submission.ID,
+		EventID:      event.ID,
+		RepoURL:      repoCloneURL(submission.GithubRepo),
+		Deadline:     teamDeadline(event, extension),
+		TriggeredBy:  triggeredBy,
+	}
+
+	if err := s.fetch(record); err != nil {
+		record.Status = models.RepositorySnapshotFailed
+		record.Error = err.Error()
+	} else {
+		record.Status = models.RepositorySnapshotCompleted
+	}
+
+	if err := s.snapshotRepo.Create(record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+func (s *repositorySnapshotService) fetch(record *models.RepositorySnapshot) error {
+	tmp, err := s.store.TempFile()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}()
+
+	hasher := sha256.New()
+	cidBuilder := storage.NewCIDBuilder()
+	out := &cappedWriter{w: io.MultiWriter(tmp, hasher, cidBuilder), limit: s.maxBytes}
+
+	ctx, cancel := context.WithTimeout(context.Background(), snapshotTimeout)
+	defer cancel()
+	snapshot, err := s.fetcher.Snapshot(ctx, record.RepoURL, out)
+	if out.exceeded {
+		return ErrSnapshotTooLarge
+	}
+	if err != nil {
+		return err
+	}
+
+	digest := hasher.Sum(nil)
+	cid := cidBuilder.CID()
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	if err := s.store.Put(cid, tmp); err != nil {
+		return err
+	}
+
+	late := []GitCommit{}
+	for _, commit := range snapshot.Commits {
+		if record.Deadline != nil && commit.AuthorDate.After(*record.Deadline) {
+			late = append(late, commit)
+		}
+	}
+	lateJSON, err := json.Marshal(late)
+	if err != nil {
+		return err
+	}
+
+	record.Branch = snapshot.Branch
+	record.CommitSHA = snapshot.CommitSHA
+	record.TreeHash = snapshot.TreeHash
+	record.ArchiveCID = cid
+	record.ArchiveSHA256 = hex.EncodeToString(digest)
+	record.ArchiveSize = out.written
+	record.CommitCount = len(snapshot.Commits)
+	record.LateCommitCount = len(late)
+	record.LateCommits = lateJSON
+	return nil
+}
+
+// repoCloneURL turns the submitted repository reference into something git
+// can clone; scheme-less references such as github.com/org/repo use https.
+func repoCloneURL(raw string) string {
+	raw = strings.TrimSpace(raw)
+	if strings.Contains(raw, "://") || strings.HasPrefix(raw, "git@") || strings.HasPrefix(raw, "/") {
+		return raw
+	}
+	return "https://" + raw
+}
+
+// cappedWriter counts what is written and fails once limit is exceeded.
+type cappedWriter struct {
+	w        io.Writer
+	limit    int64
+	written  int64
+	exceeded bool
+}
+
+func (c *cappedWriter) Write(p []byte) (int, error) {
+	if c.written+int64(len(p)) > c.limit {
+		c.exceeded = true
+		return 0, ErrSnapshotTooLarge
+	}
+	n, err := c.w.Write(p)
+	c.written += int64(n)
+	return n, err
+}
@@ -0,0 +1,198 @@
+package services
+
+import (
+	"encoding/json"
+	"hackathon-platform/backend/models"
+	"hackathon-platform/backend/repositories"
+	"hackathon-platform/backend/storage"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testOrganizer = "0x00000000000000000000000000000000000000aa"
+
+// testGitRepo is a working copy that pushes to a local bare repository,
+// standing in for the team's hosted repository.
+type testGitRepo struct {
+	t    *testing.T
+	work string
+	bare string
+}
+
+func newTestGitRepo(t *testing.T) *testGitRepo {
+	t.Helper()
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	root := t.TempDir()
+	repo := &testGitRepo{t: t, work: filepath.Join(root, "work"), bare: filepath.Join(root, "remote.git")}
+	repo.git(root, time.Time{}, "init", "--quiet", "--bare", "--initial-branch=main", repo.bare)
+	repo.git(root, time.Time{}, "init", "--quiet", "--initial-branch=main", repo.work)
+	repo.git(repo.work, time.Time{}, "remote", "add", "origin", repo.bare)
+	return repo
+}
+
+// commit writes file and pushes a commit authored at when.
+func (r *testGitRepo) commit(file, content string, when time.Time) string {
+	r.t.Helper()
+	if err := os.WriteFile(filepath.Join(r.work, file), []byte(content), 0o644); err != nil {
+		r.t.Fatal(err)
+	}
+	r.git(r.work, when, "add", file)
+	r.git(r.work, when, "commit", "--quiet", "-m", "update "+file)
+	r.git(r.work, when, "push", "--quiet", "origin", "main")
+	return r.git(r.work, when, "rev-parse", "HEAD")
+}
+
+func (r *testGitRepo) git(dir string, when time.Time, args ...string) string {
+	r.t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"GIT_CONFIG_NOSYSTEM=1",
+		"GIT_CONFIG_GLOBAL="+os.DevNull,
+		"GIT_AUTHOR_NAME=Team",
+		"GIT_AUTHOR_EMAIL=team@example.com",
+		"GIT_COMMITTER_NAME=Team",
+		"GIT_COMMITTER_EMAIL=team@example.com",
+	)
+	if !when.IsZero() {
+		date := when.Format(time.RFC3339)
+		cmd.Env = append(cmd.Env, "GIT_AUTHOR_DATE="+date, "GIT_COMMITTER_DATE="+date)
+	}
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		r.t.Fatalf("git %s: %v\n%s", strings.Join(args, " "), err, out)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+type stubSubmissionRepo struct {
+	repositories.SubmissionRepository
+	submission *models.Submission
+}
+
+func (r *stubSubmissionRepo) GetByID(id uint) (*models.Submission, error) {
+	return r.submission, nil
+}
+
+type stubEventRepo struct {
+	repositories.EventRepository
+	event *models.Event
+}
+
+func (r *stubEventRepo) GetByID(id uint) (*models.Event, error) {
+	return r.event, nil
+}
+
+type stubExtensionRepo struct {
+	repositories.SubmissionExtensionRepository
+}
+
+func (stubExtensionRepo) GetActive(eventID uint, teamID uint) (*models.SubmissionDeadlineExtension, error) {
+	return nil, nil
+}
+
+type memorySnapshotRepo struct {
+	repositories.RepositorySnapshotRepository
+	created []models.RepositorySnapshot
+}
+
+func (r *memorySnapshotRepo) Create(snapshot *models.RepositorySnapshot) error {
+	snapshot.ID = uint(len(r.created) + 1)
+	r.created = append(r.created, *snapshot)
+	return nil
+}
+
+func newTestSnapshotService(t *testing.T, repoPath string, deadline time.Time) (RepositorySnapshotService, storage.BlobStore) {
+	t.Helper()
+	store, err := storage.NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	fetcher, err := NewExecGitFetcher("git", "file")
+	if err != nil {
+		t.Fatal(err)
+	}
+	event := &models.Event{ID: 1, OrganizerAddress: testOrganizer, SubmissionEndTime: &deadline}
+	submission := &models.Submission{ID: 7, EventID: event.ID, TeamID: 3, GithubRepo: repoPath}
+	service := NewRepositorySnapshotService(
+		&memorySnapshotRepo{},
+		&stubSubmissionRepo{submission: submission},
+		&stubEventRepo{event: event},
+		stubExtensionRepo{},
+		store,
+		fetcher,
+		10<<20,
+	)
+	return service, store
+}
+
+func TestSnapshotPinsDeadlineStateAndFlagsLateCommits(t *testing.T) {
+	repo := newTestGitRepo(t)
+	deadline := time.Date(2026, 3, 1, 18, 0, 0, 0, time.UTC)
+	onTime := repo.commit("main.go", "package main\n", deadline.Add(-2*time.Hour))
+
+	service, store := newTestSnapshotService(t, repo.bare, deadline)
+
+	atDeadline, err := service.SnapshotSubmission(7, testOrganizer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if atDeadline.Status != models.RepositorySnapshotCompleted {
+		t.Fatalf("snapshot failed: %s", atDeadline.Error)
+	}
+	if atDeadline.CommitSHA != onTime {
+		t.Fatalf("snapshot at deadline pinned %s, want %s", atDeadline.CommitSHA, onTime)
+	}
+	if atDeadline.Branch != "main" || atDeadline.CommitCount != 1 || atDeadline.LateCommitCount != 0 {
+		t.Fatalf("unexpected snapshot at deadline: branch %q, %d commits, %d late",
+			atDeadline.Branch, atDeadline.CommitCount, atDeadline.LateCommitCount)
+	}
+	if ok, err := store.Exists(atDeadline.ArchiveCID); err != nil || !ok {
+		t.Fatalf("archive %s was not stored", atDeadline.ArchiveCID)
+	}
+
+	late := repo.commit("main.go", "package main\n\nfunc main() {}\n", deadline.Add(3*time.Hour))
+
+	afterPush, err := service.SnapshotSubmission(7, testOrganizer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if afterPush.CommitSHA != late || afterPush.CommitCount != 2 {
+		t.Fatalf("snapshot after push pinned %s with %d commits, want %s with 2", afterPush.CommitSHA, afterPush.CommitCount, late)
+	}
+	if afterPush.LateCommitCount != 1 {
+		t.Fatalf("late commit count = %d, want 1", afterPush.LateCommitCount)
+	}
+	var flagged []GitCommit
+	if err := json.Unmarshal(afterPush.LateCommits, &flagged); err != nil {
+		t.Fatal(err)
+	}
+	if len(flagged) != 1 || flagged[0].SHA != late {
+		t.Fatalf("flagged commits = %+v, want only %s", flagged, late)
+	}
+	if afterPush.ArchiveCID == atDeadline.ArchiveCID {
+		t.Fatal("the later push did not produce a new archive")
+	}
+}
+
+func TestSnapshotRecordsFailureForMissingRepository(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	missing := filepath.Join(t.TempDir(), "missing.git")
+	service, _ := newTestSnapshotService(t, missing, time.Now())
+
+	snapshot, err := service.SnapshotSubmission(7, testOrganizer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snapshot.Status != models.RepositorySnapshotFailed || snapshot.Error == "" {
+		t.Fatalf("status = %s, error = %q; want a recorded failure", snapshot.Status, snapshot.Error)
+	}
+}
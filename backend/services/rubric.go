@@ -0,0 +1,257 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"hackathon-platform/backend/models"
+	"regexp"
+	"strings"
+)
+
+var rubricKeyPattern = regexp.MustCompile(`^[a-z0-9_]{1,50}$`)
+
+// RubricCriterionInput describes one criterion when setting a rubric.
+type RubricCriterionInput struct {
+	Key         string   `json:"key" binding:"required"`
+	Name        string   `json:"name" binding:"required"`
+	Description string   `json:"description"`
+	Weight      *float64 `json:"weight"`    // Defaults to 1
+	MinScore    *int     `json:"min_score"` // Defaults to 1
+	MaxScore    *int     `json:"max_score"` // Defaults to 10
+}
+
+// SetRubricRequest replaces an event's rubric.
+type SetRubricRequest struct {
+	OrganizerAddress string                 `json:"organizer_address" binding:"required"`
+	Criteria         []RubricCriterionInput `json:"criteria"`
+}
+
+// RubricScoreInput is a judge's score for one criterion.
+type RubricScoreInput struct {
+	CriterionID uint   `json:"criterion_id" binding:"required"`
+	Score       int    `json:"score"`
+	Comment     string `json:"comment"`
+}
+
+// SubmitScorecardRequest scores a submission on every criterion of the
+// event's rubric, replacing the judge's earlier scorecard.
+type SubmitScorecardRequest struct {
+	EventID      uint               `json:"event_id" binding:"required"`
+	SubmissionID uint               `json:"submission_id" binding:"required"`
+	JudgeAddress string             `json:"judge_address" binding:"required"`
+	Scores       []RubricScoreInput `json:"scores" binding:"required"`
+	Signature    string             `json:"signature"` // EIP-712 signature over the scorecard typed data
+	Nonce        uint64             `json:"nonce"`
+	ChainID      int64              `json:"chain_id"`
+}
+
+// CriterionAverage is the mean score of one criterion across judges.
+type CriterionAverage struct {
+	CriterionID uint    `json:"criterion_id"`
+	Key         string  `json:"key"`
+	Weight      float64 `json:"weight"`
+	Average     float64 `json:"average"`
+	Count       int     `json:"count"`
+}
+
+// rubricResult aggregates the scorecards of one submission.
+type rubricResult struct {
+	total      float64 // Sum of the judges' weighted totals
+	average    float64 // Mean of the judges' weighted average scores
+	normalized float64 // Combined score under the event's normalization
+	judges     int
+	criteria   []CriterionAverage
+}
+
+// buildRubric validates a rubric request and converts it to criteria.
+func buildRubric(eventID uint, req *SetRubricRequest) ([]models.RubricCriterion, error) {
+	criteria := make([]models.RubricCriterion, 0, len(req.Criteria))
+	seen := make(map[string]bool, len(req.Criteria))
+	for i, input := range req.Criteria {
+		key := strings.ToLower(strings.TrimSpace(input.Key))
+		if !rubricKeyPattern.MatchString(key) {
+			return nil, fmt.Errorf("criterion key %q must be 1-50 lowercase letters, digits or underscores", input.Key)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("duplicate criterion %q", key)
+		}
+		seen[key] = true
+
+		name := strings.TrimSpace(input.Name)
+		if name == "" {
+			return nil, fmt.Errorf("criterion %q needs a name", key)
+		}
+		weight := 1.0
+		if input.Weight != nil {
+			weight = *input.Weight
+		}
+		if weight <= 0 {
+			return nil, fmt.Errorf("criterion %q weight must be greater than zero", key)
+		}
+		minScore, maxScore := 1, 10
+		if input.MinScore != nil {
+			minScore = *input.MinScore
+		}
+		if input.MaxScore != nil {
+			maxScore = *input.MaxScore
+		}
+		if minScore >= maxScore {
+			return nil, fmt.Errorf("criterion %q min score must be below its max score", key)
+		}
+
+		criteria = append(criteria, models.RubricCriterion{
+			EventID:     eventID,
+			Key:         key,
+			Name:        name,
+			Description: strings.TrimSpace(input.Description),
+			Weight:      weight,
+			MinScore:    minScore,
+			MaxScore:    maxScore,
+			Position:    i,
+		})
+	}
+	return criteria, nil
+}
+
+// buildScorecard checks that scores cover every criterion exactly once and
+// fall within each criterion's range.
+func buildScorecard(criteria []models.RubricCriterion, submission *models.Submission, judge string, inputs []RubricScoreInput) ([]models.RubricScore, error) {
+	if len(criteria) == 0 {
+		return nil, errors.New("event has no judging rubric")
+	}
+	byID := make(map[uint]*models.RubricCriterion, len(criteria))
+	for i := range criteria {
+		byID[criteria[i].ID] = &criteria[i]
+	}
+
+	scores := make([]models.RubricScore, 0, len(inputs))
+	seen := make(map[uint]bool, len(inputs))
+	for _, input := range inputs {
+		criterion, ok := byID[input.CriterionID]
+		if !ok {
+			return nil, fmt.Errorf("criterion %d is not part of this event's rubric", input.CriterionID)
+		}
+		if seen[criterion.ID] {
+			return nil, fmt.Errorf("criterion %q is scored twice", criterion.Key)
+		}
+		seen[criterion.ID] = true
+		if input.Score < criterion.MinScore || input.Score > criterion.MaxScore {
+			return nil, fmt.Errorf("score for %q must be between %d and %d", criterion.Key, criterion.MinScore, criterion.MaxScore)
+		}
+		scores = append(scores, models.RubricScore{
+			EventID:      submission.EventID,
+			SubmissionID: submission.ID,
+			JudgeAddress: judge,
+			CriterionID:  criterion.ID,
+			Score:        input.Score,
+			Comment:      strings.TrimSpace(input.Comment),
+		})
+	}
+	for _, criterion := range criteria {
+		if !seen[criterion.ID] {
+			return nil, fmt.Errorf("criterion %q is not scored", criterion.Key)
+		}
+	}
+	return scores, nil
+}
+
+// rubricResults aggregates scores per submission. A judge's weighted total
+// is the sum of weight x score over the criteria; dividing by the total
+// weight gives the judge's weighted average on the rubric's scale, which is
+// what normalization works on.
+func rubricResults(criteria []models.RubricCriterion, scores []models.RubricScore, method models.ScoreNormalization) map[uint]*rubricResult {
+	byID := make(map[uint]*models.RubricCriterion, len(criteria))
+	var totalWeight float64
+	for i := range criteria {
+		byID[criteria[i].ID] = &criteria[i]
+		totalWeight += criteria[i].Weight
+	}
+
+	type criterionSum struct {
+		sum   float64
+		count int
+	}
+	cards := make(map[uint]map[string]float64) // Weighted total per submission and judge
+	sums := make(map[uint]map[uint]*criterionSum)
+	for _, score := range scores {
+		criterion, ok := byID[score.CriterionID]
+		if !ok {
+			continue
+		}
+		if cards[score.SubmissionID] == nil {
+			cards[score.SubmissionID] = make(map[string]float64)
+			sums[score.SubmissionID] = make(map[uint]*criterionSum)
+		}
+		cards[score.SubmissionID][score.JudgeAddress] += criterion.Weight * float64(score.Score)
+
+		sum := sums[score.SubmissionID][criterion.ID]
+		if sum == nil {
+			sum = &criterionSum{}
+			sums[score.SubmissionID][criterion.ID] = sum
+		}
+		sum.sum += float64(score.Score)
+		sum.count++
+	}
+
+	normalized := normalizeRubricScores(method, rubricAverages(cards, totalWeight))
+
+	results := make(map[uint]*rubricResult, len(cards))
+	for submissionID, judges := range cards {
+		result := &rubricResult{judges: len(judges), normalized: normalized[submissionID]}
+		for _, weighted := range judges {
+			result.total += weighted
+			if totalWeight > 0 {
+				result.average += weighted / totalWeight
+			}
+		}
+		result.average /= float64(len(judges))
+
+		for _, criterion := range criteria {
+			average := CriterionAverage{CriterionID: criterion.ID, Key: criterion.Key, Weight: criterion.Weight}
+			if sum := sums[submissionID][criterion.ID]; sum != nil {
+				average.Average = sum.sum / float64(sum.count)
+				average.Count = sum.count
+			}
+			result.criteria = append(result.criteria, average)
+		}
+		results[submissionID] = result
+	}
+	return results
+}
+
+// rubricScorecards returns each judge's weighted average score per
+// submission.
+func rubricScorecards(criteria []models.RubricCriterion, scores []models.RubricScore) map[uint]map[string]float64 {
+	byID := make(map[uint]*models.RubricCriterion, len(criteria))
+	var totalWeight float64
+	for i := range criteria {
+		byID[criteria[i].ID] = &criteria[i]
+		totalWeight += criteria[i].Weight
+	}
+	cards := make(map[uint]map[string]float64)
+	for _, score := range scores {
+		criterion, ok := byID[score.CriterionID]
+		if !ok {
+			continue
+		}
+		if cards[score.SubmissionID] == nil {
+			cards[score.SubmissionID] = make(map[string]float64)
+		}
+		cards[score.SubmissionID][score.JudgeAddress] += criterion.Weight * float64(score.Score)
+	}
+	return rubricAverages(cards, totalWeight)
+}
+
+// rubricAverages divides weighted totals by the rubric's total weight.
+func rubricAverages(cards map[uint]map[string]float64, totalWeight float64) map[uint]map[string]float64 {
+	averages := make(map[uint]map[string]float64, len(cards))
+	for submissionID, judges := range cards {
+		averages[submissionID] = make(map[string]float64, len(judges))
+		for judge, weighted := range judges {
+			if totalWeight > 0 {
+				averages[submissionID][judge] = weighted / totalWeight
+			}
+		}
+	}
+	return averages
+}
@@ -0,0 +1,180 @@
+package services
+
+import (
+	"hackathon-platform/backend/models"
+	"math"
+	"sort"
+)
+
+// JudgeCalibration summarizes how one judge scores relative to the panel.
+// Scores are the judge's weighted averages on the rubric's scale.
+type JudgeCalibration struct {
+	JudgeAddress string  `json:"judge_address"`
+	Scorecards   int     `json:"scorecards"`
+	Mean         float64 `json:"mean"`
+	StdDev       float64 `json:"std_dev"`
+	Min          float64 `json:"min"`
+	Max          float64 `json:"max"`
+	Offset       float64 `json:"offset"` // Mean minus the mean of all scorecards; positive judges score generously
+}
+
+// CalibrationReport lists every judge's calibration for an event.
+type CalibrationReport struct {
+	EventID       uint                      `json:"event_id"`
+	Normalization models.ScoreNormalization `json:"normalization"`
+	Mean          float64                   `json:"mean"`
+	StdDev        float64                   `json:"std_dev"`
+	Judges        []JudgeCalibration        `json:"judges"`
+}
+
+func validScoreNormalization(method models.ScoreNormalization) bool {
+	switch method {
+	case models.NormalizationNone, models.NormalizationZScore, models.NormalizationRank, models.NormalizationTrimmedMean:
+		return true
+	}
+	return false
+}
+
+// normalizeRubricScores combines the judges' scorecards of each submission
+// into one score using the event's normalization. cards maps a submission
+// to each judge's weighted average score.
+//
+// With zscore and rank the judge's scores are first rescaled against the
+// other submissions that judge scored, so the result is the mean standard
+// score (0 is an average submission for that judge) or the mean percentile
+// rank (0 to 1). trimmed_mean keeps the rubric's scale but drops the
+// highest and lowest scorecard once a submission has three or more.
+func normalizeRubricScores(method models.ScoreNormalization, cards map[uint]map[string]float64) map[uint]float64 {
+	byJudge := make(map[string]map[uint]float64)
+	for submissionID, judges := range cards {
+		for judge, score := range judges {
+			if byJudge[judge] == nil {
+				byJudge[judge] = make(map[uint]float64)
+			}
+			byJudge[judge][submissionID] = score
+		}
+	}
+
+	adjusted := make(map[uint]map[string]float64, len(cards))
+	switch method {
+	case models.NormalizationZScore:
+		for judge, scored := range byJudge {
+			mean, stdDev := meanStdDev(scoreValues(scored))
+			for submissionID, score := range scored {
+				z := 0.0
+				if stdDev > 0 {
+					z = (score - mean) / stdDev
+				}
+				setCard(adjusted, submissionID, judge, z)
+			}
+		}
+	case models.NormalizationRank:
+		for judge, scored := range byJudge {
+			for submissionID, score := range scored {
+				setCard(adjusted, submissionID, judge, percentileRank(score, scored))
+			}
+		}
+	default:
+		adjusted = cards
+	}
+
+	results := make(map[uint]float64, len(adjusted))
+	for submissionID, judges := range adjusted {
+		values := make([]float64, 0, len(judges))
+		for _, score := range judges {
+			values = append(values, score)
+		}
+		if method == models.NormalizationTrimmedMean && len(values) >= 3 {
+			sort.Float64s(values)
+			values = values[1 : len(values)-1]
+		}
+		results[submissionID], _ = meanStdDev(values)
+	}
+	return results
+}
+
+// calibrateJudges reports each judge's scoring statistics against the
+// mean of every scorecard in the event.
+func calibrateJudges(cards map[uint]map[string]float64) (float64, float64, []JudgeCalibration) {
+	byJudge := make(map[string][]float64)
+	var all []float64
+	for _, judges := range cards {
+		for judge, score := range judges {
+			byJudge[judge] = append(byJudge[judge], score)
+			all = append(all, score)
+		}
+	}
+	eventMean, eventStdDev := meanStdDev(all)
+
+	calibrations := make([]JudgeCalibration, 0, len(byJudge))
+	for judge, values := range byJudge {
+		mean, stdDev := meanStdDev(values)
+		calibration := JudgeCalibration{
+			JudgeAddress: judge,
+			Scorecards:   len(values),
+			Mean:         mean,
+			StdDev:       stdDev,
+			Min:          values[0],
+			Max:          values[0],
+			Offset:       mean - eventMean,
+		}
+		for _, value := range values {
+			calibration.Min = math.Min(calibration.Min, value)
+			calibration.Max = math.Max(calibration.Max, value)
+		}
+		calibrations = append(calibrations, calibration)
+	}
+	sort.Slice(calibrations, func(i, j int) bool {
+		return calibrations[i].JudgeAddress < calibrations[j].JudgeAddress
+	})
+	return eventMean, eventStdDev, calibrations
+}
+
+// percentileRank places score among a judge's scores from 0 (lowest) to 1
+// (highest), splitting ties evenly. A judge with a single scorecard gives 0.5.
+func percentileRank(score float64, scored map[uint]float64) float64 {
+	if len(scored) < 2 {
+		return 0.5
+	}
+	var below, equal float64
+	for _, other := range scored {
+		if other < score {
+			below++
+		} else if other == score {
+			equal++
+		}
+	}
+	return (below + (equal-1)/2) / float64(len(scored)-1)
+}
+
+// meanStdDev returns the mean and population standard deviation of values.
+func meanStdDev(values []float64) (float64, float64) {
+	if len(values) == 0 {
+		return 0, 0
+	}
+	var sum float64
+	for _, value := range values {
+		sum += value
+	}
+	mean := sum / float64(len(values))
+	var squares float64
+	for _, value := range values {
+		squares += (value - mean) * (value - mean)
+	}
+	return mean, math.Sqrt(squares / float64(len(values)))
+}
+
+func scoreValues(scores map[uint]float64) []float64 {
+	values := make([]float64, 0, len(scores))
+	for _, score := range scores {
+		values = append(values, score)
+	}
+	return values
+}
+
+func setCard(cards map[uint]map[string]float64, submissionID uint, judge string, score float64) {
+	if cards[submissionID] == nil {
+		cards[submissionID] = make(map[string]float64)
+	}
+	cards[submissionID][judge] = score
+}
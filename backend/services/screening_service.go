@@ -0,0 +1,515 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"hackathon-platform/backend/models"
+	"hackathon-platform/backend/repositories"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Screening decisions reported for a submission
+const (
+	ScreeningDecisionPending  = "pending"
+	ScreeningDecisionApproved = "approved"
+	ScreeningDecisionRejected = "rejected"
+	ScreeningDecisionTied     = "tied" // Every assigned reviewer decided without a majority; the organizer decides
+)
+
+const defaultReviewersPerSubmission = 2
+
+type ScreeningService interface {
+	GetPolicy(eventID uint) (*models.ScreeningPolicy, error)
+	SetPolicy(eventID uint, req *SetScreeningPolicyRequest) (*models.ScreeningPolicy, error)
+	AssignReviewers(eventID uint, req *AssignReviewersRequest) (*AssignReviewersResult, error)
+	RemoveAssignment(submissionID uint, assignmentID uint, organizerAddress string) error
+	SubmitVerdict(submissionID uint, req *ScreeningVerdictRequest) (*ScreeningStatus, error)
+	GetStatus(submissionID uint, organizerAddress string) (*ScreeningStatus, error)
+	ListQueue(reviewerAddress string, eventID uint) ([]ScreeningQueueItem, error)
+}
+
+type screeningService struct {
+	screeningRepo  repositories.ScreeningRepository
+	submissionRepo repositories.SubmissionRepository
+	eventRepo      repositories.EventRepository
+	teamRepo       repositories.TeamRepository
+	statusRepo     repositories.SubmissionStatusChangeRepository
+	live           LiveNotifier
+}
+
+func NewScreeningService(
+	screeningRepo repositories.ScreeningRepository,
+	submissionRepo repositories.SubmissionRepository,
+	eventRepo repositories.EventRepository,
+	teamRepo repositories.TeamRepository,
+	statusRepo repositories.SubmissionStatusChangeRepository,
+	live LiveNotifier,
+) ScreeningService {
+	return &screeningService{
+		screeningRepo:  screeningRepo,
+		submissionRepo: submissionRepo,
+		eventRepo:      eventRepo,
+		teamRepo:       teamRepo,
+		statusRepo:     statusRepo,
+		live:           live,
+	}
+}
+
+type SetScreeningPolicyRequest struct {
+	OrganizerAddress       string               `json:"organizer_address" binding:"required"`
+	Enabled                bool                 `json:"enabled"`
+	ReviewersPerSubmission int                  `json:"reviewers_per_submission"` // Defaults to 2
+	Quorum                 int                  `json:"quorum"`                   // Defaults to reviewers_per_submission
+	Rule                   models.ScreeningRule `json:"rule"`                     // Defaults to majority
+}
+
+type AssignReviewersRequest struct {
+	OrganizerAddress string   `json:"organizer_address" binding:"required"`
+	Reviewers        []string `json:"reviewers" binding:"required"`
+	SubmissionIDs    []uint   `json:"submission_ids"` // Defaults to every pending submission of the event
+}
+
+// AssignReviewersResult lists the new assignments and the submissions that
+// could not get enough reviewers from the given pool.
+type AssignReviewersResult struct {
+	Assignments  []models.ScreeningAssignment `json:"assignments"`
+	Understaffed []uint                       `json:"understaffed"`
+}
+
+type ScreeningVerdictRequest struct {
+	ReviewerAddress string                       `json:"reviewer_address" binding:"required"`
+	Verdict         models.ScreeningVerdictValue `json:"verdict" binding:"required"`
+	Notes           string                       `json:"notes"` // Required for ineligible verdicts
+}
+
+// ScreeningTally counts the verdicts on a submission's current content.
+type ScreeningTally struct {
+	Eligible    int `json:"eligible"`
+	Ineligible  int `json:"ineligible"`
+	Outstanding int `json:"outstanding"` // Assigned reviewers without a current verdict
+	Stale       int `json:"stale"`       // Verdicts given on content the team has since changed
+}
+
+// ScreeningStatus is the organizer's view of a submission's screening,
+// including every verdict ever recorded.
+type ScreeningStatus struct {
+	SubmissionID uint                         `json:"submission_id"`
+	Status       models.SubmissionStatus      `json:"status"`
+	Policy       *models.ScreeningPolicy      `json:"policy"`
+	Tally        ScreeningTally               `json:"tally"`
+	Decision     string                       `json:"decision"`
+	Assignments  []models.ScreeningAssignment `json:"assignments"`
+	Verdicts     []models.ScreeningVerdict    `json:"verdicts"` // Audit trail, oldest first
+}
+
+// ScreeningQueueItem is a submission waiting for the reviewer's verdict.
+type ScreeningQueueItem struct {
+	AssignmentID uint              `json:"assignment_id"`
+	AssignedAt   time.Time         `json:"assigned_at"`
+	Stale        bool              `json:"stale"` // The reviewer decided on earlier content
+	Submission   models.Submission `json:"submission"`
+}
+
+// GetPolicy returns the event's screening policy, or a disabled default when
+// none was configured.
+func (s *screeningService) GetPolicy(eventID uint) (*models.ScreeningPolicy, error) {
+	if _, err := s.eventRepo.GetByID(eventID); err != nil {
+		return nil, errors.New("event not found")
+	}
+	return s.policy(eventID)
+}
+
+func (s *screeningService) SetPolicy(eventID uint, req *SetScreeningPolicyRequest) (*models.ScreeningPolicy, error) {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+	if normalizeAddress(event.OrganizerAddress) != normalizeAddress(req.OrganizerAddress) {
+		return nil, errors.New("only the organizer can configure screening")
+	}
+
+	rule := req.Rule
+	if rule == "" {
+		rule = models.ScreeningRuleMajority
+	}
+	if rule != models.ScreeningRuleMajority && rule != models.ScreeningRuleUnanimous {
+		return nil, fmt.Errorf("unknown screening rule %q", rule)
+	}
+	reviewers := req.ReviewersPerSubmission
+	if reviewers == 0 {
+		reviewers = defaultReviewersPerSubmission
+	}
+	if reviewers < 1 {
+		return nil, errors.New("reviewers per submission must be at least 1")
+	}
+	quorum := req.Quorum
+	if quorum == 0 {
+		quorum = reviewers
+	}
+	if quorum < 1 || quorum > reviewers {
+		return nil, errors.New("quorum must be between 1 and reviewers per submission")
+	}
+
+	policy, err := s.policy(eventID)
+	if err != nil {
+		return nil, err
+	}
+	policy.Enabled = req.Enabled
+	policy.ReviewersPerSubmission = reviewers
+	policy.Quorum = quorum
+	policy.Rule = rule
+	policy.UpdatedBy = req.OrganizerAddress
+	if err := s.screeningRepo.SavePolicy(policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// AssignReviewers tops up each pending submission to the policy's reviewer
+// count, picking the least loaded reviewers first. Reviewers are never
+// assigned to their own team's submission.
+func (s *screeningService) AssignReviewers(eventID uint, req *AssignReviewersRequest) (*AssignReviewersResult, error) {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+	if normalizeAddress(event.OrganizerAddress) != normalizeAddress(req.OrganizerAddress) {
+		return nil, errors.New("only the organizer can assign reviewers")
+	}
+	policy, err := s.enabledPolicy(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	var reviewers []string
+	for _, reviewer := range req.Reviewers {
+		reviewer = normalizeAddress(reviewer)
+		if reviewer != "" && !containsString(reviewers, reviewer) {
+			reviewers = append(reviewers, reviewer)
+		}
+	}
+	if len(reviewers) == 0 {
+		return nil, errors.New("at least one reviewer is required")
+	}
+
+	submissions, err := s.submissionRepo.GetByEventID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	wanted := make(map[uint]bool, len(req.SubmissionIDs))
+	for _, id := range req.SubmissionIDs {
+		wanted[id] = true
+	}
+
+	existing, err := s.screeningRepo.ListAssignmentsByEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+	load := make(map[string]int)
+	assigned := make(map[uint]map[string]bool)
+	for _, assignment := range existing {
+		load[assignment.ReviewerAddress]++
+		if assigned[assignment.SubmissionID] == nil {
+			assigned[assignment.SubmissionID] = make(map[string]bool)
+		}
+		assigned[assignment.SubmissionID][assignment.ReviewerAddress] = true
+	}
+
+	result := &AssignReviewersResult{Assignments: []models.ScreeningAssignment{}, Understaffed: []uint{}}
+	for i := range submissions {
+		submission := &submissions[i]
+		if submission.Status != models.SubmissionStatusPending || (len(wanted) > 0 && !wanted[submission.ID]) {
+			continue
+		}
+		need := policy.ReviewersPerSubmission - len(assigned[submission.ID])
+		if need <= 0 {
+			continue
+		}
+		team, err := s.teamRepo.GetByID(submission.TeamID)
+		if err != nil {
+			return nil, err
+		}
+
+		var candidates []string
+		for _, reviewer := range reviewers {
+			if !assigned[submission.ID][reviewer] && !isTeamMember(team, reviewer) {
+				candidates = append(candidates, reviewer)
+			}
+		}
+		sort.SliceStable(candidates, func(a, b int) bool {
+			return load[candidates[a]] < load[candidates[b]]
+		})
+		if len(candidates) < need {
+			result.Understaffed = append(result.Understaffed, submission.ID)
+			need = len(candidates)
+		}
+		for _, reviewer := range candidates[:need] {
+			load[reviewer]++
+			result.Assignments = append(result.Assignments, models.ScreeningAssignment{
+				SubmissionID:    submission.ID,
+				EventID:         eventID,
+				ReviewerAddress: reviewer,
+				AssignedBy:      req.OrganizerAddress,
+			})
+		}
+	}
+
+	if err := s.screeningRepo.CreateAssignments(result.Assignments); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RemoveAssignment unassigns a reviewer who has not decided yet, so the
+// submission can be reassigned.
+func (s *screeningService) RemoveAssignment(submissionID uint, assignmentID uint, organizerAddress string) error {
+	assignment, err := s.screeningRepo.GetAssignment(assignmentID)
+	if err != nil {
+		return err
+	}
+	if assignment.SubmissionID != submissionID {
+		return errors.New("assignment does not belong to this submission")
+	}
+	event, err := s.eventRepo.GetByID(assignment.EventID)
+	if err != nil {
+		return errors.New("event not found")
+	}
+	if normalizeAddress(event.OrganizerAddress) != normalizeAddress(organizerAddress) {
+		return errors.New("only the organizer can remove reviewers")
+	}
+	if assignment.Verdict != "" {
+		return errors.New("reviewer has already given a verdict")
+	}
+	return s.screeningRepo.DeleteAssignment(assignment.ID)
+}
+
+// SubmitVerdict records a reviewer's verdict on the submission's current
+// content. Once the quorum is reached the policy's rule approves or rejects
+// the submission.
+func (s *screeningService) SubmitVerdict(submissionID uint, req *ScreeningVerdictRequest) (*ScreeningStatus, error) {
+	submission, err := s.submissionRepo.GetByID(submissionID)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := s.enabledPolicy(submission.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if submission.Status != models.SubmissionStatusPending {
+		return nil, errors.New("submission is not awaiting screening")
+	}
+	if req.Verdict != models.ScreeningVerdictEligible && req.Verdict != models.ScreeningVerdictIneligible {
+		return nil, fmt.Errorf("unknown verdict %q", req.Verdict)
+	}
+	notes := strings.TrimSpace(req.Notes)
+	if req.Verdict == models.ScreeningVerdictIneligible && notes == "" {
+		return nil, errors.New("notes are required for an ineligible verdict")
+	}
+
+	reviewer := normalizeAddress(req.ReviewerAddress)
+	assignment, err := s.screeningRepo.GetAssignmentByReviewer(submission.ID, reviewer)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("reviewer is not assigned to this submission")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	assignment.Verdict = req.Verdict
+	assignment.Notes = notes
+	assignment.SubmissionHash = submission.SubmissionHash
+	assignment.DecidedAt = &now
+	verdict := &models.ScreeningVerdict{
+		AssignmentID:    assignment.ID,
+		SubmissionID:    submission.ID,
+		ReviewerAddress: reviewer,
+		Verdict:         req.Verdict,
+		Notes:           notes,
+		SubmissionHash:  submission.SubmissionHash,
+	}
+	if err := s.screeningRepo.RecordVerdict(assignment, verdict); err != nil {
+		return nil, err
+	}
+
+	status, err := s.status(submission, policy)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.applyDecision(submission, status, reviewer); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// GetStatus returns the screening state and full audit trail of a submission.
+func (s *screeningService) GetStatus(submissionID uint, organizerAddress string) (*ScreeningStatus, error) {
+	submission, err := s.submissionRepo.GetByID(submissionID)
+	if err != nil {
+		return nil, err
+	}
+	if normalizeAddress(submission.Event.OrganizerAddress) != normalizeAddress(organizerAddress) {
+		return nil, errors.New("only the organizer can view screening verdicts")
+	}
+	policy, err := s.policy(submission.EventID)
+	if err != nil {
+		return nil, err
+	}
+	return s.status(submission, policy)
+}
+
+// ListQueue returns the pending submissions waiting for the reviewer,
+// including ones changed since the reviewer's verdict.
+func (s *screeningService) ListQueue(reviewerAddress string, eventID uint) ([]ScreeningQueueItem, error) {
+	assignments, err := s.screeningRepo.ListOpenAssignmentsByReviewer(normalizeAddress(reviewerAddress), eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := []ScreeningQueueItem{}
+	for _, assignment := range assignments {
+		submission, err := s.submissionRepo.GetByID(assignment.SubmissionID)
+		if err != nil {
+			return nil, err
+		}
+		stale := assignment.Verdict != "" && assignment.SubmissionHash != submission.SubmissionHash
+		if assignment.Verdict != "" && !stale {
+			continue
+		}
+		queue = append(queue, ScreeningQueueItem{
+			AssignmentID: assignment.ID,
+			AssignedAt:   assignment.CreatedAt,
+			Stale:        stale,
+			Submission:   *submission,
+		})
+	}
+	return queue, nil
+}
+
+func (s *screeningService) policy(eventID uint) (*models.ScreeningPolicy, error) {
+	policy, err := s.screeningRepo.GetPolicy(eventID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &models.ScreeningPolicy{
+			EventID:                eventID,
+			ReviewersPerSubmission: defaultReviewersPerSubmission,
+			Quorum:                 defaultReviewersPerSubmission,
+			Rule:                   models.ScreeningRuleMajority,
+		}, nil
+	}
+	return policy, err
+}
+
+func (s *screeningService) enabledPolicy(eventID uint) (*models.ScreeningPolicy, error) {
+	policy, err := s.policy(eventID)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.Enabled {
+		return nil, errors.New("screening is not enabled for this event")
+	}
+	return policy, nil
+}
+
+func (s *screeningService) status(submission *models.Submission, policy *models.ScreeningPolicy) (*ScreeningStatus, error) {
+	assignments, err := s.screeningRepo.ListAssignmentsBySubmission(submission.ID)
+	if err != nil {
+		return nil, err
+	}
+	verdicts, err := s.screeningRepo.ListVerdictsBySubmission(submission.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tally := tallyScreening(assignments, submission.SubmissionHash)
+	return &ScreeningStatus{
+		SubmissionID: submission.ID,
+		Status:       submission.Status,
+		Policy:       policy,
+		Tally:        tally,
+		Decision:     screeningDecision(policy, tally),
+		Assignments:  assignments,
+		Verdicts:     verdicts,
+	}, nil
+}
+
+// applyDecision moves a pending submission to approved or rejected once its
+// screening is decided, crediting the reviewer whose verdict decided it.
+func (s *screeningService) applyDecision(submission *models.Submission, status *ScreeningStatus, reviewer string) error {
+	var next models.SubmissionStatus
+	switch status.Decision {
+	case ScreeningDecisionApproved:
+		next = models.SubmissionStatusApproved
+	case ScreeningDecisionRejected:
+		next = models.SubmissionStatusRejected
+	default:
+		return nil
+	}
+
+	comment := fmt.Sprintf("screening %s: %d eligible, %d ineligible (%s, quorum %d)",
+		status.Decision, status.Tally.Eligible, status.Tally.Ineligible, status.Policy.Rule, status.Policy.Quorum)
+	from := submission.Status
+	submission.Status = next
+	submission.ReviewerComment = comment
+	if err := s.submissionRepo.Update(submission); err != nil {
+		return err
+	}
+	if err := s.statusRepo.Create(&models.SubmissionStatusChange{
+		SubmissionID: submission.ID,
+		FromStatus:   from,
+		ToStatus:     next,
+		ChangedBy:    reviewer,
+		Comment:      comment,
+	}); err != nil {
+		return err
+	}
+
+	status.Status = next
+	notifyLive(s.live, submission.EventID, LiveTopicSubmissions)
+	return nil
+}
+
+// tallyScreening counts verdicts given on the content with hash; verdicts on
+// earlier content count as stale and their reviewers as outstanding.
+func tallyScreening(assignments []models.ScreeningAssignment, hash string) ScreeningTally {
+	var tally ScreeningTally
+	for _, assignment := range assignments {
+		switch {
+		case assignment.Verdict == "":
+			tally.Outstanding++
+		case assignment.SubmissionHash != hash:
+			tally.Stale++
+			tally.Outstanding++
+		case assignment.Verdict == models.ScreeningVerdictEligible:
+			tally.Eligible++
+		case assignment.Verdict == models.ScreeningVerdictIneligible:
+			tally.Ineligible++
+		}
+	}
+	return tally
+}
+
+func screeningDecision(policy *models.ScreeningPolicy, tally ScreeningTally) string {
+	if tally.Eligible+tally.Ineligible < policy.Quorum {
+		return ScreeningDecisionPending
+	}
+	if policy.Rule == models.ScreeningRuleUnanimous {
+		if tally.Ineligible > 0 {
+			return ScreeningDecisionRejected
+		}
+		return ScreeningDecisionApproved
+	}
+
+	switch {
+	case tally.Eligible > tally.Ineligible:
+		return ScreeningDecisionApproved
+	case tally.Ineligible > tally.Eligible:
+		return ScreeningDecisionRejected
+	case tally.Outstanding == 0:
+		return ScreeningDecisionTied
+	}
+	return ScreeningDecisionPending
+}
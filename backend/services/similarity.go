@@ -0,0 +1,336 @@
+package services
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hackathon-platform/backend/models"
+	"hash/fnv"
+	"net/url"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Reasons a pair of submissions is flagged.
+const (
+	SimilarityReasonSameRepo    = "same_repo"
+	SimilarityReasonSharedFiles = "shared_files"
+	SimilarityReasonSimilarText = "similar_text"
+)
+
+const (
+	shingleSize       = 3   // Tokens per shingle
+	minhashSize       = 128 // Hash functions per signature
+	minhashBands      = 32  // LSH bands; rows per band = minhashSize / minhashBands
+	minSimilarityText = 8   // Texts with fewer tokens are too short to compare
+)
+
+// SimilaritySubject identifies one side of a flagged pair.
+type SimilaritySubject struct {
+	SubmissionID uint                    `json:"submission_id"`
+	EventID      uint                    `json:"event_id"`
+	TeamID       uint                    `json:"team_id"`
+	TeamName     string                  `json:"team_name"`
+	Title        string                  `json:"title"`
+	Status       models.SubmissionStatus `json:"status"`
+}
+
+// SimilarityPair is a pair of submissions that look like duplicates.
+type SimilarityPair struct {
+	A              SimilaritySubject `json:"a"`
+	B              SimilaritySubject `json:"b"`
+	Reasons        []string          `json:"reasons"`
+	SameRepo       string            `json:"same_repo,omitempty"`    // Normalized repository both submissions point to
+	SharedFiles    []string          `json:"shared_files,omitempty"` // Content hashes present in both
+	TextSimilarity float64           `json:"text_similarity"`        // Estimated Jaccard similarity of title and description
+	Score          float64           `json:"score"`
+	CrossEvent     bool              `json:"cross_event"`
+	SameTeam       bool              `json:"same_team"`
+}
+
+// SimilarityReport lists flagged pairs for an event, most similar first.
+type SimilarityReport struct {
+	EventID      uint             `json:"event_id"`
+	Threshold    float64          `json:"threshold"`
+	IncludePast  bool             `json:"include_past"`
+	Compared     int              `json:"compared"`      // Submissions of the event
+	PastCompared int              `json:"past_compared"` // Submissions of earlier events
+	Pairs        []SimilarityPair `json:"pairs"`
+}
+
+// similarityDoc holds the comparable features of one submission.
+type similarityDoc struct {
+	submission *models.Submission
+	current    bool
+	repo       string
+	files      map[string]bool
+	signature  []uint64 // Nil when the text is too short
+}
+
+func newSimilarityDoc(submission *models.Submission, current bool) *similarityDoc {
+	doc := &similarityDoc{
+		submission: submission,
+		current:    current,
+		repo:       normalizeRepoURL(submission.GithubRepo),
+		files:      make(map[string]bool),
+	}
+	for _, file := range submission.Files {
+		if key := fileContentKey(file); key != "" {
+			doc.files[key] = true
+		}
+	}
+	if shingles := textShingles(submission.Title + "\n" + submission.Description); shingles != nil {
+		doc.signature = minhashSignature(shingles)
+	}
+	return doc
+}
+
+// findSimilarPairs compares every current document with the other current
+// documents and with the past ones. Candidates come from exact repository and
+// file matches and from MinHash LSH buckets, so unrelated pairs are never
+// scored.
+func findSimilarPairs(docs []*similarityDoc, threshold float64) []SimilarityPair {
+	buckets := make(map[string][]int)
+	for i, doc := range docs {
+		if doc.repo != "" {
+			buckets["repo:"+doc.repo] = append(buckets["repo:"+doc.repo], i)
+		}
+		for key := range doc.files {
+			buckets["file:"+key] = append(buckets["file:"+key], i)
+		}
+		for _, key := range lshBandKeys(doc.signature) {
+			buckets[key] = append(buckets[key], i)
+		}
+	}
+
+	seen := make(map[[2]int]bool)
+	pairs := []SimilarityPair{}
+	for _, members := range buckets {
+		for x := 0; x < len(members); x++ {
+			for y := x + 1; y < len(members); y++ {
+				i, j := members[x], members[y]
+				if i > j {
+					i, j = j, i
+				}
+				if seen[[2]int{i, j}] || (!docs[i].current && !docs[j].current) {
+					continue
+				}
+				seen[[2]int{i, j}] = true
+				if pair, ok := compareDocs(docs[i], docs[j], threshold); ok {
+					pairs = append(pairs, pair)
+				}
+			}
+		}
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].Score != pairs[j].Score {
+			return pairs[i].Score > pairs[j].Score
+		}
+		if pairs[i].A.SubmissionID != pairs[j].A.SubmissionID {
+			return pairs[i].A.SubmissionID < pairs[j].A.SubmissionID
+		}
+		return pairs[i].B.SubmissionID < pairs[j].B.SubmissionID
+	})
+	return pairs
+}
+
+func compareDocs(a *similarityDoc, b *similarityDoc, threshold float64) (SimilarityPair, bool) {
+	// Keep the current event's submission on side A
+	if !a.current && b.current {
+		a, b = b, a
+	}
+	pair := SimilarityPair{
+		A:          similaritySubject(a.submission),
+		B:          similaritySubject(b.submission),
+		Reasons:    []string{},
+		CrossEvent: a.submission.EventID != b.submission.EventID,
+		SameTeam:   a.submission.TeamID == b.submission.TeamID,
+	}
+
+	if a.repo != "" && a.repo == b.repo {
+		pair.SameRepo = a.repo
+		pair.Reasons = append(pair.Reasons, SimilarityReasonSameRepo)
+		pair.Score = 1
+	}
+	for key := range a.files {
+		if b.files[key] {
+			pair.SharedFiles = append(pair.SharedFiles, key)
+		}
+	}
+	if len(pair.SharedFiles) > 0 {
+		sort.Strings(pair.SharedFiles)
+		pair.Reasons = append(pair.Reasons, SimilarityReasonSharedFiles)
+		pair.Score = 1
+	}
+	if a.signature != nil && b.signature != nil {
+		pair.TextSimilarity = minhashSimilarity(a.signature, b.signature)
+		if pair.TextSimilarity >= threshold {
+			pair.Reasons = append(pair.Reasons, SimilarityReasonSimilarText)
+			if pair.TextSimilarity > pair.Score {
+				pair.Score = pair.TextSimilarity
+			}
+		}
+	}
+	return pair, len(pair.Reasons) > 0
+}
+
+func similaritySubject(submission *models.Submission) SimilaritySubject {
+	return SimilaritySubject{
+		SubmissionID: submission.ID,
+		EventID:      submission.EventID,
+		TeamID:       submission.TeamID,
+		TeamName:     submission.Team.Name,
+		Title:        submission.Title,
+		Status:       submission.Status,
+	}
+}
+
+// normalizeRepoURL reduces the many spellings of a repository URL to
+// host/owner/repo, e.g. "git@github.com:Org/Repo.git" and
+// "https://www.github.com/org/repo/tree/main" both become github.com/org/repo.
+func normalizeRepoURL(raw string) string {
+	raw = strings.ToLower(strings.TrimSpace(raw))
+	if raw == "" {
+		return ""
+	}
+	if strings.HasPrefix(raw, "git@") {
+		raw = "ssh://" + strings.Replace(strings.TrimPrefix(raw, "git@"), ":", "/", 1)
+	}
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Host == "" {
+		return ""
+	}
+	host := strings.TrimPrefix(parsed.Hostname(), "www.")
+
+	var segments []string
+	for _, segment := range strings.Split(parsed.Path, "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+	if len(segments) == 0 {
+		// A bare host does not identify a repository
+		return ""
+	}
+	if len(segments) > 2 {
+		// Only owner/repo identifies the repository; the rest points inside it
+		segments = segments[:2]
+	}
+	segments[len(segments)-1] = strings.TrimSuffix(segments[len(segments)-1], ".git")
+	return strings.Join(append([]string{host}, segments...), "/")
+}
+
+// fileContentKey identifies a file by content: its CID when uploaded to the
+// built-in store, otherwise its declared hash.
+func fileContentKey(file models.SubmissionFile) string {
+	if file.CID != "" {
+		return file.CID
+	}
+	return strings.ToLower(strings.TrimSpace(file.Hash))
+}
+
+// textTokens splits text into lowercase words. Han, Kana and Hangul
+// characters are one token each since those scripts have no spaces.
+func textTokens(text string) []string {
+	var tokens []string
+	var word []rune
+	flush := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, string(word))
+			word = word[:0]
+		}
+	}
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul):
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			word = append(word, r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
+
+// textShingles returns the set of token n-grams of text, or nil when the
+// text is too short to compare meaningfully.
+func textShingles(text string) map[string]bool {
+	tokens := textTokens(text)
+	if len(tokens) < minSimilarityText {
+		return nil
+	}
+	shingles := make(map[string]bool)
+	for i := 0; i+shingleSize <= len(tokens); i++ {
+		shingles[strings.Join(tokens[i:i+shingleSize], " ")] = true
+	}
+	return shingles
+}
+
+// minhashSignature computes a MinHash signature; the fraction of equal
+// positions in two signatures estimates the Jaccard similarity of the sets.
+func minhashSignature(shingles map[string]bool) []uint64 {
+	signature := make([]uint64, minhashSize)
+	for i := range signature {
+		signature[i] = ^uint64(0)
+	}
+	for shingle := range shingles {
+		h := fnv.New64a()
+		h.Write([]byte(shingle))
+		base := h.Sum64()
+		for i := range signature {
+			if v := splitmix64(base ^ minhashSeed(i)); v < signature[i] {
+				signature[i] = v
+			}
+		}
+	}
+	return signature
+}
+
+func minhashSimilarity(a []uint64, b []uint64) float64 {
+	equal := 0
+	for i := range a {
+		if a[i] == b[i] {
+			equal++
+		}
+	}
+	return float64(equal) / float64(len(a))
+}
+
+// lshBandKeys buckets a signature by bands; similar signatures share at
+// least one band with high probability.
+func lshBandKeys(signature []uint64) []string {
+	if signature == nil {
+		return nil
+	}
+	rows := minhashSize / minhashBands
+	keys := make([]string, 0, minhashBands)
+	buf := make([]byte, 8*rows)
+	for band := 0; band < minhashBands; band++ {
+		for row := 0; row < rows; row++ {
+			binary.BigEndian.PutUint64(buf[row*8:], signature[band*rows+row])
+		}
+		h := fnv.New64a()
+		h.Write(buf)
+		keys = append(keys, fmt.Sprintf("lsh:%d:%x", band, h.Sum64()))
+	}
+	return keys
+}
+
+func minhashSeed(i int) uint64 {
+	return splitmix64(uint64(i) + 1)
+}
+
+func splitmix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"errors"
+	"hackathon-platform/backend/models"
+	"hackathon-platform/backend/repositories"
+)
+
+// DefaultSimilarityThreshold is the text similarity above which a pair is
+// flagged when the caller does not choose one.
+const DefaultSimilarityThreshold = 0.6
+
+type SimilarityService interface {
+	GetEventReport(eventID uint, organizerAddress string, threshold float64, includePast bool) (*SimilarityReport, error)
+}
+
+type similarityService struct {
+	submissionRepo repositories.SubmissionRepository
+	eventRepo      repositories.EventRepository
+}
+
+func NewSimilarityService(
+	submissionRepo repositories.SubmissionRepository,
+	eventRepo repositories.EventRepository,
+) SimilarityService {
+	return &similarityService{
+		submissionRepo: submissionRepo,
+		eventRepo:      eventRepo,
+	}
+}
+
+// GetEventReport flags submissions of the event that share a repository or
+// file, or have near-identical titles and descriptions, with each other or,
+// with includePast, with submissions of earlier events. Withdrawn submissions
+// are skipped.
+func (s *similarityService) GetEventReport(eventID uint, organizerAddress string, threshold float64, includePast bool) (*SimilarityReport, error) {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+	if normalizeAddress(event.OrganizerAddress) != normalizeAddress(organizerAddress) {
+		return nil, errors.New("only the organizer can view the similarity report")
+	}
+	if threshold <= 0 || threshold > 1 {
+		return nil, errors.New("threshold must be between 0 and 1")
+	}
+
+	current, err := s.submissionRepo.GetByEventID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	report := &SimilarityReport{
+		EventID:     eventID,
+		Threshold:   threshold,
+		IncludePast: includePast,
+	}
+
+	var docs []*similarityDoc
+	for i := range current {
+		if current[i].Status == models.SubmissionStatusWithdrawn {
+			continue
+		}
+		docs = append(docs, newSimilarityDoc(&current[i], true))
+		report.Compared++
+	}
+
+	if includePast {
+		past, err := s.submissionRepo.GetByEventsStartedBefore(event.StartTime)
+		if err != nil {
+			return nil, err
+		}
+		for i := range past {
+			if past[i].EventID == eventID || past[i].Status == models.SubmissionStatusWithdrawn {
+				continue
+			}
+			docs = append(docs, newSimilarityDoc(&past[i], false))
+			report.PastCompared++
+		}
+	}
+
+	report.Pairs = findSimilarPairs(docs, threshold)
+	return report, nil
+}
@@ -0,0 +1,367 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"hackathon-platform/backend/models"
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const maxTokenDecimals = 36
+
+// Where a sponsor's voting power came from.
+const (
+	VotingPowerFromRules   = "rules"   // Derived from deposits by the event's sponsor voting rules
+	VotingPowerFromManual  = "manual"  // Sum of the voting power typed into deposited sponsorships
+	VotingPowerFromDefault = "default" // No rules and no manual power, so every sponsor with a deposit counts once
+)
+
+// SponsorVotingRuleInput describes how one asset converts into voting power.
+type SponsorVotingRuleInput struct {
+	AssetType    models.AssetType   `json:"asset_type"`
+	TokenAddress string             `json:"token_address"` // Required for erc20 and nft, empty for native
+	Symbol       string             `json:"symbol"`
+	Decimals     int                `json:"decimals"`
+	Rate         string             `json:"rate"` // Voting power per whole token, e.g. "0.01" for 1 vote per 100 tokens
+	Curve        models.VotingCurve `json:"curve"`
+	Cap          float64            `json:"cap"`
+}
+
+// SetSponsorVotingRulesRequest replaces an event's sponsor voting rules.
+// An empty list falls back to the manual voting power of sponsorships.
+type SetSponsorVotingRulesRequest struct {
+	OrganizerAddress string                   `json:"organizer_address" binding:"required"`
+	Rules            []SponsorVotingRuleInput `json:"rules"`
+}
+
+// SponsorVotingPower shows how a sponsor's voting power was computed.
+type SponsorVotingPower struct {
+	SponsorID      uint                     `json:"sponsor_id"`
+	SponsorAddress string                   `json:"sponsor_address"`
+	SponsorName    string                   `json:"sponsor_name"`
+	Source         string                   `json:"source"`
+	Power          float64                  `json:"power"`
+	Assets         []AssetVotingPower       `json:"assets,omitempty"`
+	Sponsorships   []SponsorshipVotingPower `json:"sponsorships"`
+}
+
+// AssetVotingPower is the power a sponsor gets from all deposits of one
+// asset. The curve is applied to the combined deposits so splitting a
+// deposit into several sponsorships gains nothing.
+type AssetVotingPower struct {
+	AssetType    models.AssetType   `json:"asset_type"`
+	TokenAddress string             `json:"token_address"`
+	Symbol       string             `json:"symbol"`
+	Decimals     uint8              `json:"decimals"`
+	Rate         string             `json:"rate"`
+	Curve        models.VotingCurve `json:"curve"`
+	Cap          float64            `json:"cap,omitempty"`
+	Amount       string             `json:"amount"`       // Deposited smallest units
+	TokenAmount  string             `json:"token_amount"` // Amount scaled by decimals
+	Value        float64            `json:"value"`        // TokenAmount × Rate, before the curve
+	Power        float64            `json:"power"`
+}
+
+// SponsorshipVotingPower records whether a sponsorship counted and why not.
+type SponsorshipVotingPower struct {
+	SponsorshipID uint                     `json:"sponsorship_id"`
+	Status        models.SponsorshipStatus `json:"status"`
+	AssetType     models.AssetType         `json:"asset_type"`
+	TokenAddress  string                   `json:"token_address"`
+	Amount        string                   `json:"amount"`
+	VotingPower   float64                  `json:"voting_power,omitempty"` // Manual power, when no rules are set
+	Counted       bool                     `json:"counted"`
+	Reason        string                   `json:"reason,omitempty"`
+}
+
+// buildSponsorVotingRules validates a rules request and converts it to rules.
+func buildSponsorVotingRules(eventID uint, req *SetSponsorVotingRulesRequest) ([]models.SponsorVotingRule, error) {
+	rules := make([]models.SponsorVotingRule, 0, len(req.Rules))
+	seen := make(map[string]bool, len(req.Rules))
+	for i, input := range req.Rules {
+		tokenAddress := normalizeAddress(input.TokenAddress)
+		switch input.AssetType {
+		case models.AssetTypeNative:
+			if tokenAddress != "" {
+				return nil, fmt.Errorf("rule %d: native assets have no token address", i+1)
+			}
+		case models.AssetTypeERC20, models.AssetTypeNFT:
+			if !common.IsHexAddress(tokenAddress) {
+				return nil, fmt.Errorf("rule %d: invalid token address", i+1)
+			}
+		default:
+			return nil, fmt.Errorf("rule %d: invalid asset type", i+1)
+		}
+		key := string(input.AssetType) + ":" + tokenAddress
+		if seen[key] {
+			return nil, fmt.Errorf("rule %d: duplicate rule for this asset", i+1)
+		}
+		seen[key] = true
+
+		if input.Decimals < 0 || input.Decimals > maxTokenDecimals {
+			return nil, fmt.Errorf("rule %d: decimals must be between 0 and %d", i+1, maxTokenDecimals)
+		}
+		rate, ok := new(big.Rat).SetString(strings.TrimSpace(input.Rate))
+		if !ok || rate.Sign() <= 0 {
+			return nil, fmt.Errorf("rule %d: rate must be a positive number", i+1)
+		}
+
+		curve := input.Curve
+		if curve == "" {
+			curve = models.VotingCurveLinear
+		}
+		switch curve {
+		case models.VotingCurveLinear, models.VotingCurveQuadratic:
+			if input.Cap != 0 {
+				return nil, fmt.Errorf("rule %d: cap only applies to the capped curve", i+1)
+			}
+		case models.VotingCurveCapped:
+			if input.Cap <= 0 {
+				return nil, fmt.Errorf("rule %d: capped curve needs a cap greater than zero", i+1)
+			}
+		default:
+			return nil, fmt.Errorf("rule %d: unsupported curve", i+1)
+		}
+
+		rules = append(rules, models.SponsorVotingRule{
+			EventID:      eventID,
+			AssetType:    input.AssetType,
+			TokenAddress: tokenAddress,
+			Symbol:       strings.TrimSpace(input.Symbol),
+			Decimals:     uint8(input.Decimals),
+			Rate:         formatRat(rate, maxTokenDecimals),
+			Curve:        curve,
+			Cap:          input.Cap,
+		})
+	}
+	return rules, nil
+}
+
+// sponsorVotingPower computes a sponsor's voting power for an event. Only
+// deposited sponsorships count. Without rules the manual power of those
+// sponsorships is summed, with a floor of one vote for a sponsor that has
+// deposited at least once; a sponsor with nothing deposited has no power.
+func sponsorVotingPower(sponsor models.Sponsor, sponsorships []models.Sponsorship, rules []models.SponsorVotingRule) *SponsorVotingPower {
+	result := &SponsorVotingPower{
+		SponsorID:      sponsor.ID,
+		SponsorAddress: normalizeAddress(sponsor.Address),
+		SponsorName:    sponsor.Name,
+		Sponsorships:   []SponsorshipVotingPower{},
+	}
+
+	if len(rules) == 0 {
+		result.Source = VotingPowerFromManual
+		deposited := 0
+		for _, sship := range sponsorships {
+			entry := sponsorshipEntry(sship)
+			entry.VotingPower = sship.VotingPower
+			if sship.Status == models.SponsorshipStatusDeposited {
+				deposited++
+			}
+			switch {
+			case sship.Status != models.SponsorshipStatusDeposited:
+				entry.Reason = "sponsorship has not been deposited"
+			case sship.VotingPower <= 0:
+				entry.Reason = "no voting power was set on this sponsorship"
+			default:
+				entry.Counted = true
+				result.Power += sship.VotingPower
+			}
+			result.Sponsorships = append(result.Sponsorships, entry)
+		}
+		if result.Power <= 0 && deposited > 0 {
+			result.Source = VotingPowerFromDefault
+			result.Power = 1
+		}
+		return result
+	}
+
+	result.Source = VotingPowerFromRules
+	totals := make(map[int]*big.Int, len(rules))
+	for _, sship := range sponsorships {
+		entry := sponsorshipEntry(sship)
+		ruleIndex := matchVotingRule(rules, sship)
+		amount, ok := new(big.Int).SetString(strings.TrimSpace(sship.Amount), 10)
+		switch {
+		case sship.Status != models.SponsorshipStatusDeposited:
+			entry.Reason = "sponsorship has not been deposited"
+		case ruleIndex < 0:
+			entry.Reason = "no voting rule for this asset"
+		case !ok || amount.Sign() < 0:
+			entry.Reason = "amount is not a whole number of smallest units"
+		default:
+			entry.Counted = true
+			if totals[ruleIndex] == nil {
+				totals[ruleIndex] = new(big.Int)
+			}
+			totals[ruleIndex].Add(totals[ruleIndex], amount)
+		}
+		result.Sponsorships = append(result.Sponsorships, entry)
+	}
+
+	for i, rule := range rules {
+		total, ok := totals[i]
+		if !ok {
+			continue
+		}
+		asset := assetVotingPower(rule, total)
+		result.Assets = append(result.Assets, asset)
+		result.Power += asset.Power
+	}
+	return result
+}
+
+// assetVotingPower converts a deposited total into power under rule.
+func assetVotingPower(rule models.SponsorVotingRule, amount *big.Int) AssetVotingPower {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(rule.Decimals)), nil)
+	tokens := new(big.Rat).SetFrac(amount, scale)
+	rate, ok := new(big.Rat).SetString(rule.Rate)
+	if !ok {
+		rate = new(big.Rat)
+	}
+	value, _ := new(big.Rat).Mul(tokens, rate).Float64()
+
+	curve := rule.Curve
+	if curve == "" {
+		curve = models.VotingCurveLinear
+	}
+	power := value
+	switch curve {
+	case models.VotingCurveQuadratic:
+		power = math.Sqrt(value)
+	case models.VotingCurveCapped:
+		power = math.Min(value, rule.Cap)
+	}
+
+	return AssetVotingPower{
+		AssetType:    rule.AssetType,
+		TokenAddress: rule.TokenAddress,
+		Symbol:       rule.Symbol,
+		Decimals:     rule.Decimals,
+		Rate:         rule.Rate,
+		Curve:        curve,
+		Cap:          rule.Cap,
+		Amount:       amount.String(),
+		TokenAmount:  formatRat(tokens, int(rule.Decimals)),
+		Value:        value,
+		Power:        power,
+	}
+}
+
+// matchVotingRule returns the index of the rule for the sponsorship's
+// asset, or -1.
+func matchVotingRule(rules []models.SponsorVotingRule, sship models.Sponsorship) int {
+	tokenAddress := normalizeAddress(sship.TokenAddress)
+	for i, rule := range rules {
+		if rule.AssetType == sship.AssetType && rule.TokenAddress == tokenAddress {
+			return i
+		}
+	}
+	return -1
+}
+
+func sponsorshipEntry(sship models.Sponsorship) SponsorshipVotingPower {
+	return SponsorshipVotingPower{
+		SponsorshipID: sship.ID,
+		Status:        sship.Status,
+		AssetType:     sship.AssetType,
+		TokenAddress:  sship.TokenAddress,
+		Amount:        sship.Amount,
+	}
+}
+
+// formatRat prints r with at most prec decimals and no trailing zeros.
+func formatRat(r *big.Rat, prec int) string {
+	s := r.FloatString(prec)
+	if strings.Contains(s, ".") {
+		s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	}
+	return s
+}
+
+// validSponsorshipAmount reports whether amount is a non-negative integer
+// of smallest units.
+func validSponsorshipAmount(amount string) bool {
+	value, ok := new(big.Int).SetString(strings.TrimSpace(amount), 10)
+	return ok && value.Sign() >= 0
+}
+
+// GetVotingRules returns an event's sponsor voting rules.
+func (s *sponsorshipService) GetVotingRules(eventID uint) ([]models.SponsorVotingRule, error) {
+	if _, err := s.eventRepo.GetByID(eventID); err != nil {
+		return nil, err
+	}
+	return s.ruleRepo.ListByEvent(eventID)
+}
+
+// SetVotingRules replaces an event's sponsor voting rules. Votes already
+// cast keep the weight they were recorded with.
+func (s *sponsorshipService) SetVotingRules(eventID uint, req *SetSponsorVotingRulesRequest) ([]models.SponsorVotingRule, error) {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+	if normalizeAddress(event.OrganizerAddress) != normalizeAddress(req.OrganizerAddress) {
+		return nil, errors.New("only organizer can set sponsor voting rules")
+	}
+
+	rules, err := buildSponsorVotingRules(eventID, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ruleRepo.ReplaceForEvent(eventID, rules); err != nil {
+		return nil, err
+	}
+	return s.ruleRepo.ListByEvent(eventID)
+}
+
+// GetVotingPower shows the voting power of every sponsor of the event, or
+// of one sponsor when sponsorAddress is given.
+func (s *sponsorshipService) GetVotingPower(eventID uint, sponsorAddress string) ([]SponsorVotingPower, error) {
+	if _, err := s.eventRepo.GetByID(eventID); err != nil {
+		return nil, err
+	}
+	rules, err := s.ruleRepo.ListByEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if sponsorAddress != "" {
+		sponsor, err := s.sponsorRepo.GetByAddress(normalizeAddress(sponsorAddress))
+		if err != nil {
+			return nil, errors.New("sponsor with this address not found")
+		}
+		sponsorships, err := s.sponsorshipRepo.GetByEventAndSponsor(eventID, sponsor.ID)
+		if err != nil {
+			return nil, err
+		}
+		return []SponsorVotingPower{*sponsorVotingPower(*sponsor, sponsorships, rules)}, nil
+	}
+
+	sponsorships, err := s.sponsorshipRepo.GetByEventID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	bySponsor := make(map[uint][]models.Sponsorship)
+	sponsors := make(map[uint]models.Sponsor)
+	for _, sship := range sponsorships {
+		bySponsor[sship.SponsorID] = append(bySponsor[sship.SponsorID], sship)
+		sponsors[sship.SponsorID] = sship.Sponsor
+	}
+	ids := make([]uint, 0, len(bySponsor))
+	for id := range bySponsor {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	powers := make([]SponsorVotingPower, 0, len(ids))
+	for _, id := range ids {
+		powers = append(powers, *sponsorVotingPower(sponsors[id], bySponsor[id], rules))
+	}
+	return powers, nil
+}
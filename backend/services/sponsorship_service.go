@@ -15,23 +15,30 @@ type SponsorshipService interface {
 	RejectSponsorship(id uint, organizerAddress string) (*models.Sponsorship, error)
 	UpdateDepositStatus(id uint, txHash string) (*models.Sponsorship, error)
 	DeleteSponsorship(id uint) error
+
+	GetVotingRules(eventID uint) ([]models.SponsorVotingRule, error)
+	SetVotingRules(eventID uint, req *SetSponsorVotingRulesRequest) ([]models.SponsorVotingRule, error)
+	GetVotingPower(eventID uint, sponsorAddress string) ([]SponsorVotingPower, error)
 }
 
 type sponsorshipService struct {
 	sponsorshipRepo repositories.SponsorshipRepository
 	eventRepo       repositories.EventRepository
 	sponsorRepo     repositories.SponsorRepository
+	ruleRepo        repositories.SponsorVotingRuleRepository
 }
 
 func NewSponsorshipService(
 	sponsorshipRepo repositories.SponsorshipRepository,
 	eventRepo repositories.EventRepository,
 	sponsorRepo repositories.SponsorRepository,
+	ruleRepo repositories.SponsorVotingRuleRepository,
 ) SponsorshipService {
 	return &sponsorshipService{
 		sponsorshipRepo: sponsorshipRepo,
 		eventRepo:       eventRepo,
 		sponsorRepo:     sponsorRepo,
+		ruleRepo:        ruleRepo,
 	}
 }
 
@@ -39,12 +46,12 @@ type CreateSponsorshipRequest struct {
 	EventID       uint             `json:"event_id" binding:"required"`
 	SponsorID     uint             `json:"sponsor_id" binding:"required"`
 	AssetType     models.AssetType `json:"asset_type" binding:"required"`
-	TokenAddress  string           `json:"token_address"` // ERC20 address or NFT contract
-	TokenID       string           `json:"token_id"`      // NFT token ID
-	Amount        string           `json:"amount" binding:"required"`
+	TokenAddress  string           `json:"token_address"`             // ERC20 address or NFT contract
+	TokenID       string           `json:"token_id"`                  // NFT token ID
+	Amount        string           `json:"amount" binding:"required"` // Whole number of wei/smallest units
 	AmountDisplay string           `json:"amount_display"`
 	VotingWeight  string           `json:"voting_weight"` // e.g., "1 USDC = 1 vote"
-	VotingPower   float64          `json:"voting_power"`  // numeric multiplier for sponsor voting
+	VotingPower   float64          `json:"voting_power"`  // numeric multiplier for sponsor voting; ignored when the event has voting rules
 	Benefits      string           `json:"benefits"`
 }
 
@@ -78,6 +85,10 @@ func (s *sponsorshipService) CreateSponsorship(req *CreateSponsorshipRequest) (*
 		return nil, errors.New("invalid asset type")
 	}
 
+	if !validSponsorshipAmount(req.Amount) {
+		return nil, errors.New("amount must be a whole number of smallest units")
+	}
+
 	sponsorship := &models.Sponsorship{
 		EventID:       req.EventID,
 		SponsorID:     req.SponsorID,
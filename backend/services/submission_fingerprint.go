@@ -0,0 +1,209 @@
+package services
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sort"
+)
+
+// Fingerprint leaf kinds. The metadata leaf is always index 0; file leaves
+// follow, sorted by their canonical encoding so input order does not matter.
+const (
+	FingerprintLeafMetadata = "metadata"
+	FingerprintLeafFile     = "file"
+)
+
+// SubmissionMetadata is the canonical metadata leaf of a fingerprint.
+type SubmissionMetadata struct {
+	EventID       uint   `json:"event_id"`
+	TeamID        uint   `json:"team_id"`
+	Title         string `json:"title"`
+	Description   string `json:"description"`
+	GithubRepo    string `json:"github_repo"`
+	DemoURL       string `json:"demo_url"`
+	Documentation string `json:"documentation"`
+	StorageURL    string `json:"storage_url"`
+
+	Declarations map[string]string `json:"declarations,omitempty"`
+}
+
+// FingerprintLeaf is one leaf of the fingerprint tree with its audit path.
+type FingerprintLeaf struct {
+	Index    int      `json:"index"`
+	Kind     string   `json:"kind"`
+	Data     string   `json:"data"` // Canonical JSON that was hashed
+	LeafHash string   `json:"leaf_hash"`
+	Proof    []string `json:"proof"`
+}
+
+// SubmissionFingerprint describes how a submission hash is derived.
+type SubmissionFingerprint struct {
+	SubmissionID uint              `json:"submission_id"`
+	Root         string            `json:"root"`
+	StoredHash   string            `json:"stored_hash"`
+	Matches      bool              `json:"matches"`
+	TreeSize     int               `json:"tree_size"`
+	Leaves       []FingerprintLeaf `json:"leaves"`
+}
+
+// VerifySubmissionContent is a full submission to recompute the root from.
+type VerifySubmissionContent struct {
+	SubmissionMetadata
+	Files []SubmissionSnapshotFile `json:"files"`
+}
+
+// VerifySubmissionRequest checks a submission against its stored hash, either
+// by recomputing the whole tree from Submission or by checking a single leaf
+// (Leaf data or LeafHash) with an inclusion proof.
+type VerifySubmissionRequest struct {
+	SubmissionID uint                     `json:"submission_id" binding:"required"`
+	Submission   *VerifySubmissionContent `json:"submission"`
+	Leaf         string                   `json:"leaf"`
+	LeafHash     string                   `json:"leaf_hash"`
+	LeafIndex    int                      `json:"leaf_index"`
+	TreeSize     int                      `json:"tree_size"`
+	Proof        []string                 `json:"proof"`
+}
+
+type VerifySubmissionResult struct {
+	Valid        bool   `json:"valid"`
+	StoredHash   string `json:"stored_hash"`
+	ComputedRoot string `json:"computed_root,omitempty"`
+	Reason       string `json:"reason,omitempty"`
+}
+
+// canonicalJSON encodes v without HTML escaping so that independent
+// implementations can reproduce the bytes with a plain JSON encoder.
+func canonicalJSON(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+func fingerprintLeafData(metadata SubmissionMetadata, files []SubmissionSnapshotFile) ([][]byte, error) {
+	meta, err := canonicalJSON(metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	fileData := make([][]byte, 0, len(files))
+	for _, file := range files {
+		data, err := canonicalJSON(file)
+		if err != nil {
+			return nil, err
+		}
+		fileData = append(fileData, data)
+	}
+	sort.Slice(fileData, func(i, j int) bool {
+		return bytes.Compare(fileData[i], fileData[j]) < 0
+	})
+
+	return append([][]byte{meta}, fileData...), nil
+}
+
+func submissionMetadata(eventID, teamID uint, snapshot SubmissionSnapshot) SubmissionMetadata {
+	return SubmissionMetadata{
+		EventID:       eventID,
+		TeamID:        teamID,
+		Title:         snapshot.Title,
+		Description:   snapshot.Description,
+		GithubRepo:    snapshot.GithubRepo,
+		DemoURL:       snapshot.DemoURL,
+		Documentation: snapshot.Documentation,
+		StorageURL:    snapshot.StorageURL,
+		Declarations:  snapshot.Declarations,
+	}
+}
+
+// buildFingerprint computes the Merkle tree of a submission's content.
+func buildFingerprint(metadata SubmissionMetadata, files []SubmissionSnapshotFile) (*SubmissionFingerprint, error) {
+	data, err := fingerprintLeafData(metadata, files)
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make([][]byte, len(data))
+	for i, d := range data {
+		hashes[i] = merkleLeafHash(d)
+	}
+
+	fp := &SubmissionFingerprint{
+		Root:     hex.EncodeToString(merkleRoot(hashes)),
+		TreeSize: len(hashes),
+		Leaves:   make([]FingerprintLeaf, len(hashes)),
+	}
+	for i := range hashes {
+		kind := FingerprintLeafFile
+		if i == 0 {
+			kind = FingerprintLeafMetadata
+		}
+		fp.Leaves[i] = FingerprintLeaf{
+			Index:    i,
+			Kind:     kind,
+			Data:     string(data[i]),
+			LeafHash: hex.EncodeToString(hashes[i]),
+			Proof:    encodeHashes(merkleInclusionProof(hashes, i)),
+		}
+	}
+	return fp, nil
+}
+
+// fingerprintRoot returns the deterministic submission hash.
+func fingerprintRoot(eventID, teamID uint, snapshot SubmissionSnapshot) (string, error) {
+	fp, err := buildFingerprint(submissionMetadata(eventID, teamID, snapshot), snapshot.Files)
+	if err != nil {
+		return "", err
+	}
+	return fp.Root, nil
+}
+
+// verifyFingerprint checks a verification request against a stored root.
+func verifyFingerprint(storedHash string, req *VerifySubmissionRequest) (*VerifySubmissionResult, error) {
+	result := &VerifySubmissionResult{StoredHash: storedHash}
+	root, err := decodeHash(storedHash)
+	if err != nil {
+		result.Reason = "stored hash is not a fingerprint root"
+		return result, nil
+	}
+
+	if req.Submission != nil {
+		fp, err := buildFingerprint(req.Submission.SubmissionMetadata, req.Submission.Files)
+		if err != nil {
+			return nil, err
+		}
+		result.ComputedRoot = fp.Root
+		result.Valid = fp.Root == hex.EncodeToString(root)
+		if !result.Valid {
+			result.Reason = "recomputed root does not match the stored hash"
+		}
+		return result, nil
+	}
+
+	var leafHash []byte
+	switch {
+	case req.Leaf != "":
+		leafHash = merkleLeafHash([]byte(req.Leaf))
+	case req.LeafHash != "":
+		if leafHash, err = decodeHash(req.LeafHash); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("either submission or leaf with proof is required")
+	}
+
+	proof, err := decodeHashes(req.Proof)
+	if err != nil {
+		return nil, err
+	}
+	result.Valid = verifyMerkleInclusion(leafHash, req.LeafIndex, req.TreeSize, proof, root)
+	if !result.Valid {
+		result.Reason = "inclusion proof does not lead to the stored hash"
+	}
+	return result, nil
+}
@@ -0,0 +1,293 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hackathon-platform/backend/models"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Fields that can be required, and the URL fields patterns can apply to.
+var (
+	requirableSubmissionFields = []string{"description", "github_repo", "demo_url", "documentation", "storage_url", "files"}
+	submissionURLFields        = []string{"github_repo", "demo_url", "documentation", "storage_url"}
+)
+
+// SubmissionDeclaration is a statement every team must make, e.g. the
+// project's open-source license. Options restricts the accepted values.
+type SubmissionDeclaration struct {
+	Key      string   `json:"key"`
+	Label    string   `json:"label"`
+	Required bool     `json:"required"`
+	Options  []string `json:"options,omitempty"`
+}
+
+// SetSubmissionRequirementsRequest replaces an event's requirements.
+type SetSubmissionRequirementsRequest struct {
+	OrganizerAddress     string                  `json:"organizer_address" binding:"required"`
+	RequiredFields       string                  `json:"required_fields"`
+	RequiredFileTypes    string                  `json:"required_file_types"`
+	URLPatterns          map[string]string       `json:"url_patterns"`
+	MaxDescriptionLength int                     `json:"max_description_length"`
+	Declarations         []SubmissionDeclaration `json:"declarations"`
+}
+
+// FieldError reports one field that does not meet the requirements.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// SubmissionValidationError lists every requirement a submission misses.
+type SubmissionValidationError struct {
+	Fields []FieldError
+}
+
+func (e *SubmissionValidationError) Error() string {
+	parts := make([]string, 0, len(e.Fields))
+	for _, field := range e.Fields {
+		parts = append(parts, field.Field+" "+field.Message)
+	}
+	return "submission does not meet the event requirements: " + strings.Join(parts, "; ")
+}
+
+// buildRequirements validates a request and converts it to a record.
+func buildRequirements(eventID uint, req *SetSubmissionRequirementsRequest) (*models.SubmissionRequirements, error) {
+	requiredFields := splitList(req.RequiredFields)
+	for _, field := range requiredFields {
+		if !containsString(requirableSubmissionFields, field) {
+			return nil, fmt.Errorf("field %q cannot be required", field)
+		}
+	}
+	if req.MaxDescriptionLength < 0 {
+		return nil, errors.New("max description length cannot be negative")
+	}
+
+	for field, pattern := range req.URLPatterns {
+		if !containsString(submissionURLFields, field) {
+			return nil, fmt.Errorf("field %q is not a URL field", field)
+		}
+		if _, err := compileURLPattern(pattern); err != nil {
+			return nil, fmt.Errorf("invalid pattern for %s: %v", field, err)
+		}
+	}
+
+	seen := make(map[string]bool, len(req.Declarations))
+	for i := range req.Declarations {
+		declaration := &req.Declarations[i]
+		declaration.Key = strings.TrimSpace(declaration.Key)
+		if declaration.Key == "" {
+			return nil, errors.New("declaration key is required")
+		}
+		if seen[declaration.Key] {
+			return nil, fmt.Errorf("duplicate declaration %q", declaration.Key)
+		}
+		seen[declaration.Key] = true
+	}
+
+	// File types use the upload format: ".pdf", "application/pdf" or "video/*"
+	fileTypes := splitList(req.RequiredFileTypes)
+	for i, fileType := range fileTypes {
+		fileType = strings.ToLower(fileType)
+		if !strings.HasPrefix(fileType, ".") && !strings.Contains(fileType, "/") {
+			fileType = "." + fileType
+		}
+		fileTypes[i] = fileType
+	}
+
+	patterns, err := json.Marshal(req.URLPatterns)
+	if err != nil {
+		return nil, err
+	}
+	if req.Declarations == nil {
+		req.Declarations = []SubmissionDeclaration{}
+	}
+	declarations, err := json.Marshal(req.Declarations)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.SubmissionRequirements{
+		EventID:              eventID,
+		RequiredFields:       strings.Join(requiredFields, ","),
+		RequiredFileTypes:    strings.Join(fileTypes, ","),
+		URLPatterns:          patterns,
+		MaxDescriptionLength: req.MaxDescriptionLength,
+		Declarations:         declarations,
+		UpdatedBy:            req.OrganizerAddress,
+	}, nil
+}
+
+// validateSubmission checks a submission against its event's requirements
+// and reports every failing field at once.
+func validateSubmission(requirements *models.SubmissionRequirements, submission *models.Submission) error {
+	var errs []FieldError
+	fail := func(field, message string) {
+		errs = append(errs, FieldError{Field: field, Message: message})
+	}
+
+	values := map[string]string{
+		"description":   submission.Description,
+		"github_repo":   submission.GithubRepo,
+		"demo_url":      submission.DemoURL,
+		"documentation": submission.Documentation,
+		"storage_url":   submission.StorageURL,
+	}
+
+	for _, field := range splitList(requirements.RequiredFields) {
+		if field == "files" {
+			if len(submission.Files) == 0 {
+				fail("files", "is required")
+			}
+			continue
+		}
+		if strings.TrimSpace(values[field]) == "" {
+			fail(field, "is required")
+		}
+	}
+
+	if max := requirements.MaxDescriptionLength; max > 0 && utf8.RuneCountInString(submission.Description) > max {
+		fail("description", fmt.Sprintf("must be at most %d characters", max))
+	}
+
+	var patterns map[string]string
+	if len(requirements.URLPatterns) > 0 {
+		if err := json.Unmarshal(requirements.URLPatterns, &patterns); err != nil {
+			return err
+		}
+	}
+	for _, field := range submissionURLFields {
+		pattern, ok := patterns[field]
+		value := strings.TrimSpace(values[field])
+		if !ok || value == "" {
+			continue
+		}
+		re, err := compileURLPattern(pattern)
+		if err != nil {
+			return err
+		}
+		if !matchURLPattern(re, pattern, value) {
+			fail(field, "must match "+pattern)
+		}
+	}
+
+	for _, fileType := range splitList(requirements.RequiredFileTypes) {
+		found := false
+		for _, file := range submission.Files {
+			if uploadTypeAllowed(fileType, file.FileType, file.FileName) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			fail("files", "must include a "+fileType+" file")
+		}
+	}
+
+	var declarations []SubmissionDeclaration
+	if len(requirements.Declarations) > 0 {
+		if err := json.Unmarshal(requirements.Declarations, &declarations); err != nil {
+			return err
+		}
+	}
+	made := submissionDeclarations(submission)
+	known := make(map[string]bool, len(declarations))
+	for _, declaration := range declarations {
+		known[declaration.Key] = true
+		field := "declarations." + declaration.Key
+		value := strings.TrimSpace(made[declaration.Key])
+		switch {
+		case value == "":
+			if declaration.Required {
+				fail(field, "is required")
+			}
+		case len(declaration.Options) > 0 && !containsString(declaration.Options, value):
+			fail(field, "must be one of "+strings.Join(declaration.Options, ", "))
+		}
+	}
+	for key := range made {
+		if !known[key] {
+			fail("declarations."+key, "is not a declaration of this event")
+		}
+	}
+
+	if len(errs) > 0 {
+		return &SubmissionValidationError{Fields: errs}
+	}
+	return nil
+}
+
+// compileURLPattern compiles a URL field pattern so it has to match the
+// whole value, not just some part of it.
+func compileURLPattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + pattern + ")$")
+}
+
+// matchURLPattern matches value against a compiled URL pattern. A pattern
+// without a "/" is a domain rule, e.g. `(www\.)?github\.com`, and is matched
+// against the URL's host only, so the domain cannot be smuggled into the
+// path or query. Other patterns are matched against the whole URL.
+func matchURLPattern(re *regexp.Regexp, pattern, value string) bool {
+	if strings.Contains(pattern, "/") {
+		return re.MatchString(value)
+	}
+	raw := value
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Hostname() == "" {
+		return false
+	}
+	return re.MatchString(strings.ToLower(parsed.Hostname()))
+}
+
+// encodeDeclarations stores declaration answers, dropping empty values.
+func encodeDeclarations(declarations map[string]string) (json.RawMessage, error) {
+	cleaned := make(map[string]string, len(declarations))
+	for key, value := range declarations {
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if key != "" && value != "" {
+			cleaned[key] = value
+		}
+	}
+	if len(cleaned) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(cleaned)
+}
+
+// submissionDeclarations decodes the declarations stored on a submission.
+func submissionDeclarations(submission *models.Submission) map[string]string {
+	if len(submission.Declarations) == 0 {
+		return nil
+	}
+	var declarations map[string]string
+	if err := json.Unmarshal(submission.Declarations, &declarations); err != nil {
+		return nil
+	}
+	return declarations
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
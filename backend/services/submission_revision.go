@@ -0,0 +1,138 @@
+package services
+
+import (
+	"hackathon-platform/backend/models"
+	"sort"
+)
+
+// SubmissionSnapshot is the canonical content of a submission captured in
+// each revision. Field order is fixed, so the JSON encoding is stable. The
+// revision hash is the fingerprint root of this content.
+type SubmissionSnapshot struct {
+	Title         string                   `json:"title"`
+	Description   string                   `json:"description"`
+	GithubRepo    string                   `json:"github_repo"`
+	DemoURL       string                   `json:"demo_url"`
+	Documentation string                   `json:"documentation"`
+	StorageURL    string                   `json:"storage_url"`
+	Declarations  map[string]string        `json:"declarations,omitempty"`
+	Files         []SubmissionSnapshotFile `json:"files"`
+}
+
+type SubmissionSnapshotFile struct {
+	FileName string `json:"file_name"`
+	FileType string `json:"file_type"`
+	URL      string `json:"url"`
+	Hash     string `json:"hash"`
+	CID      string `json:"cid,omitempty"`
+	Size     int64  `json:"size,omitempty"`
+}
+
+// FieldChange describes a scalar field that differs between two revisions.
+type FieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// FileChange describes a file kept under the same name with new content.
+type FileChange struct {
+	FileName string                 `json:"file_name"`
+	From     SubmissionSnapshotFile `json:"from"`
+	To       SubmissionSnapshotFile `json:"to"`
+}
+
+// RevisionDiff lists what changed between two revisions of a submission.
+type RevisionDiff struct {
+	SubmissionID uint                     `json:"submission_id"`
+	From         int                      `json:"from"`
+	To           int                      `json:"to"`
+	FromHash     string                   `json:"from_hash"`
+	ToHash       string                   `json:"to_hash"`
+	Fields       []FieldChange            `json:"fields"`
+	FilesAdded   []SubmissionSnapshotFile `json:"files_added"`
+	FilesRemoved []SubmissionSnapshotFile `json:"files_removed"`
+	FilesChanged []FileChange             `json:"files_changed"`
+}
+
+func snapshotSubmission(submission *models.Submission) SubmissionSnapshot {
+	snapshot := SubmissionSnapshot{
+		Title:         submission.Title,
+		Description:   submission.Description,
+		GithubRepo:    submission.GithubRepo,
+		DemoURL:       submission.DemoURL,
+		Documentation: submission.Documentation,
+		StorageURL:    submission.StorageURL,
+		Declarations:  submissionDeclarations(submission),
+		Files:         []SubmissionSnapshotFile{},
+	}
+	for _, file := range submission.Files {
+		snapshot.Files = append(snapshot.Files, SubmissionSnapshotFile{
+			FileName: file.FileName,
+			FileType: file.FileType,
+			URL:      file.URL,
+			Hash:     file.Hash,
+			CID:      file.CID,
+			Size:     file.Size,
+		})
+	}
+	return snapshot
+}
+
+func diffSnapshots(from, to SubmissionSnapshot) ([]FieldChange, []SubmissionSnapshotFile, []SubmissionSnapshotFile, []FileChange) {
+	fields := []FieldChange{}
+	compare := func(name, a, b string) {
+		if a != b {
+			fields = append(fields, FieldChange{Field: name, From: a, To: b})
+		}
+	}
+	compare("title", from.Title, to.Title)
+	compare("description", from.Description, to.Description)
+	compare("github_repo", from.GithubRepo, to.GithubRepo)
+	compare("demo_url", from.DemoURL, to.DemoURL)
+	compare("documentation", from.Documentation, to.Documentation)
+	compare("storage_url", from.StorageURL, to.StorageURL)
+
+	keys := make([]string, 0, len(from.Declarations)+len(to.Declarations))
+	for key := range from.Declarations {
+		keys = append(keys, key)
+	}
+	for key := range to.Declarations {
+		if _, ok := from.Declarations[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		compare("declarations."+key, from.Declarations[key], to.Declarations[key])
+	}
+
+	// Files are matched by name; a name with different content is a change
+	oldFiles := make(map[string]SubmissionSnapshotFile, len(from.Files))
+	for _, file := range from.Files {
+		oldFiles[file.FileName] = file
+	}
+
+	added := []SubmissionSnapshotFile{}
+	changed := []FileChange{}
+	seen := make(map[string]bool, len(to.Files))
+	for _, file := range to.Files {
+		seen[file.FileName] = true
+		old, ok := oldFiles[file.FileName]
+		switch {
+		case !ok:
+			added = append(added, file)
+		case old != file:
+			changed = append(changed, FileChange{FileName: file.FileName, From: old, To: file})
+		}
+	}
+
+	removed := []SubmissionSnapshotFile{}
+	for _, file := range from.Files {
+		if !seen[file.FileName] {
+			removed = append(removed, file)
+		}
+	}
+
+	return fields, added, removed, changed
+}
@@ -1,13 +1,15 @@
 package services
 
 import (
-	"crypto/sha256"
-	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"hackathon-platform/backend/models"
 	"hackathon-platform/backend/repositories"
+	"strings"
 	"time"
+
+	"gorm.io/gorm"
 )
 
 type SubmissionService interface {
@@ -16,34 +18,81 @@ type SubmissionService interface {
 	ListSubmissionsByEvent(eventID uint) ([]models.Submission, error)
 	ListAllSubmissions() ([]models.Submission, error)
 	UpdateSubmission(id uint, req *UpdateSubmissionRequest) (*models.Submission, error)
-	ApproveSubmission(id uint, organizerAddress string, comment string) (*models.Submission, error)
-	RejectSubmission(id uint, organizerAddress string, comment string) (*models.Submission, error)
-	DeleteSubmission(id uint) error
+	ApproveSubmission(id uint, organizerAddress string, comment string, override bool) (*models.Submission, error)
+	RejectSubmission(id uint, organizerAddress string, comment string, requestChanges bool, override bool) (*models.Submission, error)
+	WithdrawSubmission(id uint, memberAddress string, reason string) (*models.Submission, error)
+	GetStatusHistory(id uint) ([]models.SubmissionStatusChange, error)
+	DeleteSubmission(id uint, requesterAddress string) error
+	FinalizeSubmission(id uint, address string) (*models.Submission, error)
+	SignOffSubmission(id uint, address string) (*SignoffStatus, error)
+	GetSignoffStatus(id uint) (*SignoffStatus, error)
+	ListRevisions(submissionID uint) ([]models.SubmissionRevision, error)
+	GetRevision(submissionID uint, revision int) (*models.SubmissionRevision, error)
+	DiffRevisions(submissionID uint, from int, to int) (*RevisionDiff, error)
+	GetRevisionAtDeadline(submissionID uint) (*models.SubmissionRevision, error)
+	GrantExtension(eventID uint, req *GrantExtensionRequest) (*models.SubmissionDeadlineExtension, error)
+	ListExtensions(eventID uint) ([]models.SubmissionDeadlineExtension, error)
+	RevokeExtension(eventID uint, extensionID uint, organizerAddress string) error
+	GetRequirements(eventID uint) (*models.SubmissionRequirements, error)
+	SetRequirements(eventID uint, req *SetSubmissionRequirementsRequest) (*models.SubmissionRequirements, error)
+	GetFingerprint(id uint) (*SubmissionFingerprint, error)
+	VerifySubmission(req *VerifySubmissionRequest) (*VerifySubmissionResult, error)
 }
 
 type submissionService struct {
-	submissionRepo repositories.SubmissionRepository
-	eventRepo      repositories.EventRepository
-	teamRepo       repositories.TeamRepository
+	submissionRepo   repositories.SubmissionRepository
+	eventRepo        repositories.EventRepository
+	teamRepo         repositories.TeamRepository
+	uploadRepo       repositories.UploadedFileRepository
+	revisionRepo     repositories.SubmissionRevisionRepository
+	extensionRepo    repositories.SubmissionExtensionRepository
+	anchorRepo       repositories.SubmissionAnchorRepository
+	signoffRepo      repositories.SubmissionSignoffRepository
+	statusRepo       repositories.SubmissionStatusChangeRepository
+	requirementsRepo repositories.SubmissionRequirementsRepository
+	screeningRepo    repositories.ScreeningRepository
+	live             LiveNotifier
 }
 
 func NewSubmissionService(
 	submissionRepo repositories.SubmissionRepository,
 	eventRepo repositories.EventRepository,
 	teamRepo repositories.TeamRepository,
+	uploadRepo repositories.UploadedFileRepository,
+	revisionRepo repositories.SubmissionRevisionRepository,
+	extensionRepo repositories.SubmissionExtensionRepository,
+	anchorRepo repositories.SubmissionAnchorRepository,
+	signoffRepo repositories.SubmissionSignoffRepository,
+	statusRepo repositories.SubmissionStatusChangeRepository,
+	requirementsRepo repositories.SubmissionRequirementsRepository,
+	screeningRepo repositories.ScreeningRepository,
+	live LiveNotifier,
 ) SubmissionService {
 	return &submissionService{
-		submissionRepo: submissionRepo,
-		eventRepo:      eventRepo,
-		teamRepo:       teamRepo,
+		submissionRepo:   submissionRepo,
+		eventRepo:        eventRepo,
+		teamRepo:         teamRepo,
+		uploadRepo:       uploadRepo,
+		revisionRepo:     revisionRepo,
+		extensionRepo:    extensionRepo,
+		anchorRepo:       anchorRepo,
+		signoffRepo:      signoffRepo,
+		statusRepo:       statusRepo,
+		requirementsRepo: requirementsRepo,
+		screeningRepo:    screeningRepo,
+		live:             live,
 	}
 }
 
+// SubmissionFileRequest references a file by CID (uploaded through the
+// built-in store) or by an external URL. For CIDs the type, hash, size and URL
+// come from the verified upload record and client values are ignored.
 type SubmissionFileRequest struct {
 	FileName string `json:"file_name"`
 	FileType string `json:"file_type"`
 	URL      string `json:"url"`
 	Hash     string `json:"hash"`
+	CID      string `json:"cid"`
 }
 
 type CreateSubmissionRequest struct {
@@ -55,8 +104,18 @@ type CreateSubmissionRequest struct {
 	DemoURL       string                  `json:"demo_url"`
 	Documentation string                  `json:"documentation"`
 	StorageURL    string                  `json:"storage_url"`
-	SubmittedBy   string                  `json:"submitted_by" binding:"required"`
+	SubmittedBy   string                  `json:"submitted_by" binding:"required"` // Must be a member of the team
 	Files         []SubmissionFileRequest `json:"files"`
+	Declarations  map[string]string       `json:"declarations"` // Answers to the event's required declarations
+	Draft         bool                    `json:"draft"`        // Keep as a draft teammates can co-edit until it is finalized
+}
+
+// GrantExtensionRequest gives a team a later submission deadline.
+type GrantExtensionRequest struct {
+	TeamID           uint      `json:"team_id" binding:"required"`
+	ExtendedUntil    time.Time `json:"extended_until" binding:"required"`
+	Reason           string    `json:"reason" binding:"required"`
+	OrganizerAddress string    `json:"organizer_address" binding:"required"`
 }
 
 type UpdateSubmissionRequest struct {
@@ -67,6 +126,8 @@ type UpdateSubmissionRequest struct {
 	Documentation *string                 `json:"documentation"`
 	StorageURL    *string                 `json:"storage_url"`
 	Files         []SubmissionFileRequest `json:"files"`
+	Declarations  map[string]string       `json:"declarations"`                  // Replaces all declarations when present
+	UpdatedBy     string                  `json:"updated_by" binding:"required"` // Team member recorded as the revision author
 }
 
 func (s *submissionService) CreateSubmission(req *CreateSubmissionRequest) (*models.Submission, error) {
@@ -76,64 +137,90 @@ func (s *submissionService) CreateSubmission(req *CreateSubmissionRequest) (*mod
 		return nil, errors.New("event not found")
 	}
 
-	// Validate event stage (submission stage)
-	if event.CurrentStage != models.StageSubmission {
+	// Validate team exists and the submitter belongs to it
+	team, err := s.teamRepo.GetByID(req.TeamID)
+	if err != nil {
+		return nil, errors.New("team not found")
+	}
+	if !isTeamMember(team, req.SubmittedBy) {
+		return nil, errors.New("only team members can submit for this team")
+	}
+
+	// Validate event stage (submission stage); teams with an extension may
+	// still submit after the event has moved on
+	extension, _ := s.extensionRepo.GetActive(req.EventID, req.TeamID)
+	if extension == nil && event.CurrentStage != models.StageSubmission {
 		return nil, errors.New("event is not in submission stage")
 	}
 
-	// Validate team exists
-	if _, err := s.teamRepo.GetByID(req.TeamID); err != nil {
-		return nil, errors.New("team not found")
+	late, err := checkSubmissionWindow(event, extension, time.Now())
+	if err != nil {
+		return nil, err
 	}
 
 	// Ensure submissions are unique per team/event
 	existing, _ := s.submissionRepo.GetByTeamAndEvent(req.TeamID, req.EventID)
 	if existing != nil {
-		return nil, errors.New("team already submitted for this event")
+		return nil, errors.New("team already has an active submission for this event")
 	}
 
 	submission := &models.Submission{
-		EventID:       req.EventID,
-		TeamID:        req.TeamID,
-		Title:         req.Title,
-		Description:   req.Description,
-		GithubRepo:    req.GithubRepo,
-		DemoURL:       req.DemoURL,
-		Documentation: req.Documentation,
-		StorageURL:    req.StorageURL,
-		Status:        models.SubmissionStatusPending,
-		SubmittedBy:   req.SubmittedBy,
-		SubmittedAt:   time.Now(),
-	}
-
-	// Generate submission hash fingerprint (based on fields)
-	hashInput := fmt.Sprintf("%d|%d|%s|%s|%s|%s|%s|%s",
-		req.EventID,
-		req.TeamID,
-		req.Title,
-		req.Description,
-		req.GithubRepo,
-		req.DemoURL,
-		req.Documentation,
-		time.Now().String(),
-	)
-	submission.SubmissionHash = generateSubmissionHash(hashInput)
+		EventID:         req.EventID,
+		TeamID:          req.TeamID,
+		Title:           req.Title,
+		Description:     req.Description,
+		GithubRepo:      req.GithubRepo,
+		DemoURL:         req.DemoURL,
+		Documentation:   req.Documentation,
+		StorageURL:      req.StorageURL,
+		Status:          models.SubmissionStatusDraft,
+		SubmittedBy:     req.SubmittedBy,
+		SubmittedAt:     time.Now(),
+		CurrentRevision: 1,
+		IsLate:          late,
+	}
 
 	// Attach files
-	for _, file := range req.Files {
-		submission.Files = append(submission.Files, models.SubmissionFile{
-			FileName: file.FileName,
-			FileType: file.FileType,
-			URL:      file.URL,
-			Hash:     file.Hash,
-		})
+	files, err := s.buildSubmissionFiles(req.EventID, req.Files)
+	if err != nil {
+		return nil, err
+	}
+	submission.Files = files
+
+	if submission.Declarations, err = encodeDeclarations(req.Declarations); err != nil {
+		return nil, err
 	}
 
-	err = s.submissionRepo.Create(submission)
+	// Drafts may be incomplete; requirements apply once the team submits
+	if !req.Draft {
+		if err := s.checkRequirements(submission); err != nil {
+			return nil, err
+		}
+	}
+
+	// Fingerprint is the Merkle root over metadata and files
+	if submission.SubmissionHash, err = submissionFingerprintRoot(submission); err != nil {
+		return nil, err
+	}
+
+	revision, err := newRevision(submission, req.SubmittedBy)
 	if err != nil {
 		return nil, err
 	}
+	if err := s.submissionRepo.CreateWithRevision(submission, revision); err != nil {
+		return nil, err
+	}
 
+	if !req.Draft {
+		if err := s.finalize(submission, event, team, req.SubmittedBy); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.logStatusChange(submission, "", req.SubmittedBy, ""); err != nil {
+		return nil, err
+	}
+
+	notifyLive(s.live, submission.EventID, LiveTopicSubmissions)
 	return submission, nil
 }
 
@@ -155,8 +242,25 @@ func (s *submissionService) UpdateSubmission(id uint, req *UpdateSubmissionReque
 		return nil, err
 	}
 
-	if submission.Status != models.SubmissionStatusPending {
-		return nil, errors.New("only pending submissions can be updated")
+	if !submissionEditable(submission.Status) {
+		return nil, errors.New("only draft, pending or reopened submissions can be updated")
+	}
+	from := submission.Status
+
+	team, err := s.teamRepo.GetByID(submission.TeamID)
+	if err != nil {
+		return nil, errors.New("team not found")
+	}
+	if !isTeamMember(team, req.UpdatedBy) {
+		return nil, errors.New("only team members can update this submission")
+	}
+
+	event, err := s.eventRepo.GetByID(submission.EventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+	if err := s.checkTeamWindow(submission, event); err != nil {
+		return nil, err
 	}
 
 	if req.Title != nil {
@@ -179,26 +283,71 @@ func (s *submissionService) UpdateSubmission(id uint, req *UpdateSubmissionReque
 	}
 
 	if req.Files != nil {
-		submission.Files = []models.SubmissionFile{}
-		for _, file := range req.Files {
-			submission.Files = append(submission.Files, models.SubmissionFile{
-				FileName: file.FileName,
-				FileType: file.FileType,
-				URL:      file.URL,
-				Hash:     file.Hash,
-			})
+		files, err := s.buildSubmissionFiles(submission.EventID, req.Files)
+		if err != nil {
+			return nil, err
 		}
+		submission.Files = files
 	}
 
-	err = s.submissionRepo.Update(submission)
-	if err != nil {
+	if req.Declarations != nil {
+		if submission.Declarations, err = encodeDeclarations(req.Declarations); err != nil {
+			return nil, err
+		}
+	}
+
+	if submission.Status != models.SubmissionStatusDraft && submission.Status != models.SubmissionStatusChangesRequested {
+		if err := s.checkRequirements(submission); err != nil {
+			return nil, err
+		}
+	}
+
+	if submission.SubmissionHash, err = submissionFingerprintRoot(submission); err != nil {
+		return nil, err
+	}
+
+	// Only record a new revision when the content actually changed.
+	// Submissions created before revisions existed start again at 1.
+	changed := true
+	submission.CurrentRevision = 0
+	if latest, err := s.revisionRepo.GetLatest(submission.ID); err == nil {
+		changed = submission.SubmissionHash != latest.RevisionHash
+		submission.CurrentRevision = latest.Revision
+	}
+	if changed {
+		submission.CurrentRevision++
+		// Earlier signoffs were for other content; collect them again
+		if submission.Status == models.SubmissionStatusPending && event.RequireMemberSignoff {
+			submission.Status = models.SubmissionStatusAwaitingSignoff
+		}
+	}
+
+	var revision *models.SubmissionRevision
+	if changed {
+		if revision, err = newRevision(submission, req.UpdatedBy); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.submissionRepo.UpdateWithRevision(submission, req.Files != nil, revision); err != nil {
+		return nil, err
+	}
+
+	if changed {
+		if submission.Status == models.SubmissionStatusAwaitingSignoff {
+			if err := s.finalize(submission, event, team, req.UpdatedBy); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := s.logStatusChange(submission, from, req.UpdatedBy, ""); err != nil {
 		return nil, err
 	}
 
+	notifyLive(s.live, submission.EventID, LiveTopicSubmissions)
 	return submission, nil
 }
 
-func (s *submissionService) ApproveSubmission(id uint, organizerAddress string, comment string) (*models.Submission, error) {
+func (s *submissionService) ApproveSubmission(id uint, organizerAddress string, comment string, override bool) (*models.Submission, error) {
 	submission, err := s.submissionRepo.GetByID(id)
 	if err != nil {
 		return nil, err
@@ -214,6 +363,16 @@ func (s *submissionService) ApproveSubmission(id uint, organizerAddress string,
 		return nil, errors.New("only organizer can approve submissions")
 	}
 
+	if !submissionFinalized(submission.Status) {
+		return nil, errors.New("submission has not been finalized by the team")
+	}
+
+	audit, err := s.screeningOverride(submission, organizerAddress, models.ScreeningVerdictEligible, comment, override)
+	if err != nil {
+		return nil, err
+	}
+
+	from := submission.Status
 	submission.Status = models.SubmissionStatusApproved
 	submission.ReviewerComment = comment
 
@@ -221,11 +380,22 @@ func (s *submissionService) ApproveSubmission(id uint, organizerAddress string,
 	if err != nil {
 		return nil, err
 	}
+	if audit != nil {
+		if err := s.screeningRepo.RecordVerdict(nil, audit); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.logStatusChange(submission, from, organizerAddress, comment); err != nil {
+		return nil, err
+	}
 
+	notifyLive(s.live, submission.EventID, LiveTopicSubmissions)
 	return submission, nil
 }
 
-func (s *submissionService) RejectSubmission(id uint, organizerAddress string, comment string) (*models.Submission, error) {
+// RejectSubmission rejects a submission outright, or with requestChanges
+// sends it back to the team for editing and resubmission.
+func (s *submissionService) RejectSubmission(id uint, organizerAddress string, comment string, requestChanges bool, override bool) (*models.Submission, error) {
 	submission, err := s.submissionRepo.GetByID(id)
 	if err != nil {
 		return nil, err
@@ -240,22 +410,641 @@ func (s *submissionService) RejectSubmission(id uint, organizerAddress string, c
 		return nil, errors.New("only organizer can reject submissions")
 	}
 
+	if !submissionFinalized(submission.Status) {
+		return nil, errors.New("submission has not been finalized by the team")
+	}
+
+	// Asking for changes sends the submission back to the team and its
+	// reviewers; only a final rejection is a screening decision
+	var audit *models.ScreeningVerdict
+	if !requestChanges {
+		if audit, err = s.screeningOverride(submission, organizerAddress, models.ScreeningVerdictIneligible, comment, override); err != nil {
+			return nil, err
+		}
+	}
+
+	from := submission.Status
 	submission.Status = models.SubmissionStatusRejected
+	if requestChanges {
+		if strings.TrimSpace(comment) == "" {
+			return nil, errors.New("comment is required when requesting changes")
+		}
+		submission.Status = models.SubmissionStatusChangesRequested
+	}
 	submission.ReviewerComment = comment
 
 	err = s.submissionRepo.Update(submission)
 	if err != nil {
 		return nil, err
 	}
+	if audit != nil {
+		if err := s.screeningRepo.RecordVerdict(nil, audit); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.logStatusChange(submission, from, organizerAddress, comment); err != nil {
+		return nil, err
+	}
+
+	notifyLive(s.live, submission.EventID, LiveTopicSubmissions)
+	return submission, nil
+}
+
+// DeleteSubmission lets the organizer remove any submission, and team members
+// remove their own while it has not been reviewed.
+func (s *submissionService) DeleteSubmission(id uint, requesterAddress string) error {
+	submission, err := s.submissionRepo.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	event, err := s.eventRepo.GetByID(submission.EventID)
+	if err != nil {
+		return errors.New("event not found")
+	}
+	if normalizeAddress(event.OrganizerAddress) != normalizeAddress(requesterAddress) {
+		team, err := s.teamRepo.GetByID(submission.TeamID)
+		if err != nil {
+			return errors.New("team not found")
+		}
+		if !isTeamMember(team, requesterAddress) {
+			return errors.New("only team members or the organizer can delete this submission")
+		}
+		if !submissionEditable(submission.Status) {
+			return errors.New("reviewed submissions can only be deleted by the organizer")
+		}
+	}
+
+	if err := s.submissionRepo.Delete(id); err != nil {
+		return err
+	}
+
+	notifyLive(s.live, submission.EventID, LiveTopicSubmissions)
+	return nil
+}
+
+// FinalizeSubmission submits a draft, or resubmits one the organizer sent
+// back for changes. Without a signoff requirement it becomes pending right
+// away; otherwise it waits for every member.
+func (s *submissionService) FinalizeSubmission(id uint, address string) (*models.Submission, error) {
+	submission, err := s.submissionRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if submission.Status != models.SubmissionStatusDraft && submission.Status != models.SubmissionStatusChangesRequested {
+		return nil, errors.New("only drafts and submissions with changes requested can be finalized")
+	}
+	from := submission.Status
+
+	team, err := s.teamRepo.GetByID(submission.TeamID)
+	if err != nil {
+		return nil, errors.New("team not found")
+	}
+	if !isTeamMember(team, address) {
+		return nil, errors.New("only team members can finalize this submission")
+	}
 
+	event, err := s.eventRepo.GetByID(submission.EventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+	if err := s.checkTeamWindow(submission, event); err != nil {
+		return nil, err
+	}
+	if err := s.checkRequirements(submission); err != nil {
+		return nil, err
+	}
+
+	submission.SubmittedBy = address
+	submission.SubmittedAt = time.Now()
+	if err := s.finalize(submission, event, team, address); err != nil {
+		return nil, err
+	}
+	if err := s.logStatusChange(submission, from, address, ""); err != nil {
+		return nil, err
+	}
+
+	notifyLive(s.live, submission.EventID, LiveTopicSubmissions)
 	return submission, nil
 }
 
-func (s *submissionService) DeleteSubmission(id uint) error {
-	return s.submissionRepo.Delete(id)
+// SignOffSubmission records a member's approval of the current content.
+// The submission becomes pending once every member has signed off.
+func (s *submissionService) SignOffSubmission(id uint, address string) (*SignoffStatus, error) {
+	submission, err := s.submissionRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if submission.Status != models.SubmissionStatusAwaitingSignoff {
+		return nil, errors.New("submission is not awaiting signoff")
+	}
+
+	team, err := s.teamRepo.GetByID(submission.TeamID)
+	if err != nil {
+		return nil, errors.New("team not found")
+	}
+	if !isTeamMember(team, address) {
+		return nil, errors.New("only team members can sign off this submission")
+	}
+
+	event, err := s.eventRepo.GetByID(submission.EventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+	if err := s.checkTeamWindow(submission, event); err != nil {
+		return nil, err
+	}
+
+	signoffs, err := s.signoffRepo.ListBySubmissionAndHash(submission.ID, submission.SubmissionHash)
+	if err != nil {
+		return nil, err
+	}
+	for _, signoff := range signoffs {
+		if signoff.MemberAddress == normalizeAddress(address) {
+			return nil, errors.New("member already signed off this version")
+		}
+	}
+
+	if err := s.finalize(submission, event, team, address); err != nil {
+		return nil, err
+	}
+	if err := s.logStatusChange(submission, models.SubmissionStatusAwaitingSignoff, address, ""); err != nil {
+		return nil, err
+	}
+
+	notifyLive(s.live, submission.EventID, LiveTopicSubmissions)
+	return s.signoffStatus(submission, event, team)
+}
+
+func (s *submissionService) GetSignoffStatus(id uint) (*SignoffStatus, error) {
+	submission, err := s.submissionRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	event, err := s.eventRepo.GetByID(submission.EventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+	team, err := s.teamRepo.GetByID(submission.TeamID)
+	if err != nil {
+		return nil, errors.New("team not found")
+	}
+	return s.signoffStatus(submission, event, team)
+}
+
+// WithdrawSubmission lets a team member pull a submission that has not been
+// approved. The team may then create a new one for the event.
+func (s *submissionService) WithdrawSubmission(id uint, memberAddress string, reason string) (*models.Submission, error) {
+	submission, err := s.submissionRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	team, err := s.teamRepo.GetByID(submission.TeamID)
+	if err != nil {
+		return nil, errors.New("team not found")
+	}
+	if !isTeamMember(team, memberAddress) {
+		return nil, errors.New("only team members can withdraw this submission")
+	}
+
+	switch submission.Status {
+	case models.SubmissionStatusWithdrawn:
+		return nil, errors.New("submission is already withdrawn")
+	case models.SubmissionStatusApproved:
+		return nil, errors.New("approved submissions cannot be withdrawn")
+	}
+
+	from := submission.Status
+	submission.Status = models.SubmissionStatusWithdrawn
+	if err := s.submissionRepo.Update(submission); err != nil {
+		return nil, err
+	}
+	if err := s.logStatusChange(submission, from, memberAddress, reason); err != nil {
+		return nil, err
+	}
+
+	notifyLive(s.live, submission.EventID, LiveTopicSubmissions)
+	return submission, nil
+}
+
+func (s *submissionService) GetStatusHistory(id uint) ([]models.SubmissionStatusChange, error) {
+	if _, err := s.submissionRepo.GetByID(id); err != nil {
+		return nil, err
+	}
+	return s.statusRepo.ListBySubmission(id)
+}
+
+// logStatusChange appends a history entry when the status moved away from
+// from. Creation is logged with an empty from status.
+func (s *submissionService) logStatusChange(submission *models.Submission, from models.SubmissionStatus, changedBy string, comment string) error {
+	if submission.Status == from {
+		return nil
+	}
+	return s.statusRepo.Create(&models.SubmissionStatusChange{
+		SubmissionID: submission.ID,
+		FromStatus:   from,
+		ToStatus:     submission.Status,
+		ChangedBy:    changedBy,
+		Comment:      comment,
+	})
+}
+
+// checkTeamWindow enforces the team's submission window for a change and
+// marks the submission late within the grace period. Submissions the
+// organizer reopened for changes may be edited and resubmitted outside the
+// window until they are reviewed again.
+func (s *submissionService) checkTeamWindow(submission *models.Submission, event *models.Event) error {
+	if s.reopenedForChanges(submission) {
+		return nil
+	}
+
+	extension, _ := s.extensionRepo.GetActive(submission.EventID, submission.TeamID)
+	late, err := checkSubmissionWindow(event, extension, time.Now())
+	if err != nil {
+		return err
+	}
+	if late {
+		submission.IsLate = true
+	}
+	return nil
+}
+
+// reopenedForChanges reports whether the latest review of the submission
+// requested changes.
+func (s *submissionService) reopenedForChanges(submission *models.Submission) bool {
+	if submission.Status == models.SubmissionStatusChangesRequested {
+		return true
+	}
+	if !submissionEditable(submission.Status) {
+		return false
+	}
+
+	history, err := s.statusRepo.ListBySubmission(submission.ID)
+	if err != nil {
+		return false
+	}
+	for i := len(history) - 1; i >= 0; i-- {
+		switch history[i].ToStatus {
+		case models.SubmissionStatusChangesRequested:
+			return true
+		case models.SubmissionStatusApproved, models.SubmissionStatusRejected:
+			return false
+		}
+	}
+	return false
+}
+
+// finalize moves a submission out of the draft workflow on behalf of a
+// member. When the event requires signoffs the member's signoff on the
+// current hash is recorded and the submission stays awaiting_signoff until
+// every member has signed that same hash.
+func (s *submissionService) finalize(submission *models.Submission, event *models.Event, team *models.Team, address string) error {
+	if !event.RequireMemberSignoff {
+		submission.Status = models.SubmissionStatusPending
+		return s.submissionRepo.Update(submission)
+	}
+
+	signoffs, err := s.signoffRepo.ListBySubmissionAndHash(submission.ID, submission.SubmissionHash)
+	if err != nil {
+		return err
+	}
+	member := normalizeAddress(address)
+	if len(missingSignoffs([]string{member}, signoffs)) > 0 {
+		signoff := &models.SubmissionSignoff{
+			SubmissionID:   submission.ID,
+			MemberAddress:  member,
+			SubmissionHash: submission.SubmissionHash,
+		}
+		if err := s.signoffRepo.Create(signoff); err != nil {
+			return err
+		}
+		signoffs = append(signoffs, *signoff)
+	}
+
+	submission.Status = models.SubmissionStatusAwaitingSignoff
+	if len(missingSignoffs(teamMemberAddresses(team), signoffs)) == 0 {
+		submission.Status = models.SubmissionStatusPending
+	}
+	return s.submissionRepo.Update(submission)
+}
+
+func (s *submissionService) signoffStatus(submission *models.Submission, event *models.Event, team *models.Team) (*SignoffStatus, error) {
+	signoffs, err := s.signoffRepo.ListBySubmissionAndHash(submission.ID, submission.SubmissionHash)
+	if err != nil {
+		return nil, err
+	}
+	members := teamMemberAddresses(team)
+	missing := missingSignoffs(members, signoffs)
+	return &SignoffStatus{
+		SubmissionID:   submission.ID,
+		Status:         submission.Status,
+		SubmissionHash: submission.SubmissionHash,
+		Required:       event.RequireMemberSignoff,
+		Members:        members,
+		Signoffs:       signoffs,
+		Missing:        missing,
+		Complete:       len(missing) == 0,
+	}, nil
+}
+
+func (s *submissionService) ListRevisions(submissionID uint) ([]models.SubmissionRevision, error) {
+	if _, err := s.submissionRepo.GetByID(submissionID); err != nil {
+		return nil, err
+	}
+	return s.revisionRepo.ListBySubmission(submissionID)
+}
+
+func (s *submissionService) GetRevision(submissionID uint, revision int) (*models.SubmissionRevision, error) {
+	return s.revisionRepo.GetBySubmissionAndNumber(submissionID, revision)
+}
+
+func (s *submissionService) DiffRevisions(submissionID uint, from int, to int) (*RevisionDiff, error) {
+	fromRev, err := s.revisionRepo.GetBySubmissionAndNumber(submissionID, from)
+	if err != nil {
+		return nil, fmt.Errorf("revision %d not found", from)
+	}
+	toRev, err := s.revisionRepo.GetBySubmissionAndNumber(submissionID, to)
+	if err != nil {
+		return nil, fmt.Errorf("revision %d not found", to)
+	}
+
+	var fromSnap, toSnap SubmissionSnapshot
+	if err := json.Unmarshal(fromRev.Snapshot, &fromSnap); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(toRev.Snapshot, &toSnap); err != nil {
+		return nil, err
+	}
+
+	fields, added, removed, changed := diffSnapshots(fromSnap, toSnap)
+	return &RevisionDiff{
+		SubmissionID: submissionID,
+		From:         from,
+		To:           to,
+		FromHash:     fromRev.RevisionHash,
+		ToHash:       toRev.RevisionHash,
+		Fields:       fields,
+		FilesAdded:   added,
+		FilesRemoved: removed,
+		FilesChanged: changed,
+	}, nil
+}
+
+// GetRevisionAtDeadline returns the revision that was current when the
+// team's submission window closed, including any extension and grace period.
+func (s *submissionService) GetRevisionAtDeadline(submissionID uint) (*models.SubmissionRevision, error) {
+	submission, err := s.submissionRepo.GetByID(submissionID)
+	if err != nil {
+		return nil, err
+	}
+	event, err := s.eventRepo.GetByID(submission.EventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+	extension, _ := s.extensionRepo.GetActive(submission.EventID, submission.TeamID)
+	cutoff, ok := teamCutoff(event, extension)
+	if !ok {
+		return nil, errors.New("event has no submission deadline")
+	}
+
+	revision, err := s.revisionRepo.GetLatestAt(submissionID, cutoff)
+	if err != nil {
+		return nil, errors.New("submission had no revision before the deadline")
+	}
+	return revision, nil
+}
+
+func (s *submissionService) GrantExtension(eventID uint, req *GrantExtensionRequest) (*models.SubmissionDeadlineExtension, error) {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+	if normalizeAddress(event.OrganizerAddress) != normalizeAddress(req.OrganizerAddress) {
+		return nil, errors.New("only the organizer can grant deadline extensions")
+	}
+	if strings.TrimSpace(req.Reason) == "" {
+		return nil, errors.New("reason is required")
+	}
+
+	if _, err := s.teamRepo.GetByID(req.TeamID); err != nil {
+		return nil, errors.New("team not found")
+	}
+	if event.SubmissionEndTime != nil && !req.ExtendedUntil.After(*event.SubmissionEndTime) {
+		return nil, errors.New("extension must end after the event submission deadline")
+	}
+
+	// Extensions cannot reopen a window that was already anchored on-chain
+	if _, err := s.anchorRepo.GetByEventID(eventID); err == nil {
+		return nil, errors.New("submissions for this event are already anchored")
+	}
+
+	extension := &models.SubmissionDeadlineExtension{
+		EventID:       eventID,
+		TeamID:        req.TeamID,
+		ExtendedUntil: req.ExtendedUntil,
+		Reason:        strings.TrimSpace(req.Reason),
+		GrantedBy:     req.OrganizerAddress,
+	}
+	if err := s.extensionRepo.Create(extension); err != nil {
+		return nil, err
+	}
+	return extension, nil
+}
+
+func (s *submissionService) ListExtensions(eventID uint) ([]models.SubmissionDeadlineExtension, error) {
+	return s.extensionRepo.ListByEvent(eventID)
+}
+
+func (s *submissionService) RevokeExtension(eventID uint, extensionID uint, organizerAddress string) error {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		return errors.New("event not found")
+	}
+	if normalizeAddress(event.OrganizerAddress) != normalizeAddress(organizerAddress) {
+		return errors.New("only the organizer can revoke deadline extensions")
+	}
+
+	extension, err := s.extensionRepo.GetByID(extensionID)
+	if err != nil {
+		return err
+	}
+	if extension.EventID != eventID {
+		return errors.New("extension does not belong to this event")
+	}
+	return s.extensionRepo.Delete(extensionID)
+}
+
+// GetRequirements returns the event's submission requirements, or an empty
+// set when none were configured.
+func (s *submissionService) GetRequirements(eventID uint) (*models.SubmissionRequirements, error) {
+	if _, err := s.eventRepo.GetByID(eventID); err != nil {
+		return nil, errors.New("event not found")
+	}
+	requirements, err := s.requirementsRepo.GetByEventID(eventID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &models.SubmissionRequirements{EventID: eventID}, nil
+	}
+	return requirements, err
+}
+
+func (s *submissionService) SetRequirements(eventID uint, req *SetSubmissionRequirementsRequest) (*models.SubmissionRequirements, error) {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+	if normalizeAddress(event.OrganizerAddress) != normalizeAddress(req.OrganizerAddress) {
+		return nil, errors.New("only the organizer can set submission requirements")
+	}
+
+	requirements, err := buildRequirements(eventID, req)
+	if err != nil {
+		return nil, err
+	}
+	if existing, err := s.requirementsRepo.GetByEventID(eventID); err == nil {
+		requirements.ID = existing.ID
+		requirements.CreatedAt = existing.CreatedAt
+	}
+	if err := s.requirementsRepo.Save(requirements); err != nil {
+		return nil, err
+	}
+	return requirements, nil
+}
+
+// checkRequirements validates a submission against its event's requirements,
+// if the event has any.
+func (s *submissionService) checkRequirements(submission *models.Submission) error {
+	requirements, err := s.requirementsRepo.GetByEventID(submission.EventID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return validateSubmission(requirements, submission)
+}
+
+// screeningOverride keeps organizers from deciding around reviewer
+// screening. While the event's screening is enabled, the organizer may only
+// break a tie among the reviewers or explicitly override them; either way
+// the returned entry has to be appended to the screening audit trail. It
+// returns nil when screening is not enabled.
+func (s *submissionService) screeningOverride(submission *models.Submission, organizerAddress string, verdict models.ScreeningVerdictValue, comment string, override bool) (*models.ScreeningVerdict, error) {
+	policy, err := s.screeningRepo.GetPolicy(submission.EventID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !policy.Enabled {
+		return nil, nil
+	}
+
+	assignments, err := s.screeningRepo.ListAssignmentsBySubmission(submission.ID)
+	if err != nil {
+		return nil, err
+	}
+	tied := submission.Status == models.SubmissionStatusPending &&
+		screeningDecision(policy, tallyScreening(assignments, submission.SubmissionHash)) == ScreeningDecisionTied
+
+	notes := "organizer tie-break"
+	if !tied {
+		if !override {
+			return nil, errors.New("submission is decided by reviewer screening; set override to decide it directly")
+		}
+		notes = "organizer override"
+	}
+	if comment = strings.TrimSpace(comment); comment != "" {
+		notes += ": " + comment
+	}
+
+	return &models.ScreeningVerdict{
+		SubmissionID:    submission.ID,
+		ReviewerAddress: normalizeAddress(organizerAddress),
+		Verdict:         verdict,
+		Notes:           notes,
+		SubmissionHash:  submission.SubmissionHash,
+		ByOrganizer:     true,
+	}, nil
+}
+
+// newRevision builds the immutable snapshot of the submission's current
+// revision. It is stored together with the submission itself.
+func newRevision(submission *models.Submission, author string) (*models.SubmissionRevision, error) {
+	data, err := canonicalJSON(snapshotSubmission(submission))
+	if err != nil {
+		return nil, err
+	}
+	return &models.SubmissionRevision{
+		SubmissionID: submission.ID,
+		EventID:      submission.EventID,
+		Revision:     submission.CurrentRevision,
+		Snapshot:     data,
+		RevisionHash: submission.SubmissionHash,
+		Author:       author,
+	}, nil
+}
+
+// buildSubmissionFiles converts file requests into records, resolving CIDs
+// against uploads made for the same event.
+func (s *submissionService) buildSubmissionFiles(eventID uint, reqs []SubmissionFileRequest) ([]models.SubmissionFile, error) {
+	files := []models.SubmissionFile{}
+	for _, file := range reqs {
+		record := models.SubmissionFile{
+			FileName: file.FileName,
+			FileType: file.FileType,
+			URL:      file.URL,
+			Hash:     file.Hash,
+		}
+
+		if cid := strings.TrimSpace(file.CID); cid != "" {
+			upload, err := s.uploadRepo.GetByEventAndCID(eventID, cid)
+			if err != nil {
+				return nil, fmt.Errorf("file %s was not uploaded for this event", cid)
+			}
+			record.CID = upload.CID
+			record.Hash = upload.SHA256
+			record.Size = upload.Size
+			record.FileType = upload.ContentType
+			record.URL = UploadURL(upload.CID)
+			if record.FileName == "" {
+				record.FileName = upload.FileName
+			}
+		}
+
+		files = append(files, record)
+	}
+	return files, nil
+}
+
+// GetFingerprint returns the fingerprint tree of a submission's current
+// content together with every leaf's inclusion proof.
+func (s *submissionService) GetFingerprint(id uint) (*SubmissionFingerprint, error) {
+	submission, err := s.submissionRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := snapshotSubmission(submission)
+	fp, err := buildFingerprint(submissionMetadata(submission.EventID, submission.TeamID, snapshot), snapshot.Files)
+	if err != nil {
+		return nil, err
+	}
+	fp.SubmissionID = submission.ID
+	fp.StoredHash = submission.SubmissionHash
+	fp.Matches = fp.Root == submission.SubmissionHash
+	return fp, nil
+}
+
+func (s *submissionService) VerifySubmission(req *VerifySubmissionRequest) (*VerifySubmissionResult, error) {
+	submission, err := s.submissionRepo.GetByID(req.SubmissionID)
+	if err != nil {
+		return nil, err
+	}
+	return verifyFingerprint(submission.SubmissionHash, req)
 }
 
-func generateSubmissionHash(input string) string {
-	hash := sha256.Sum256([]byte(input))
-	return hex.EncodeToString(hash[:])
+func submissionFingerprintRoot(submission *models.Submission) (string, error) {
+	return fingerprintRoot(submission.EventID, submission.TeamID, snapshotSubmission(submission))
 }
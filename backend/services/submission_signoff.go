@@ -0,0 +1,77 @@
+package services
+
+import (
+	"hackathon-platform/backend/models"
+)
+
+// SignoffStatus shows which team members have approved the current content
+// of a submission.
+type SignoffStatus struct {
+	SubmissionID   uint                       `json:"submission_id"`
+	Status         models.SubmissionStatus    `json:"status"`
+	SubmissionHash string                     `json:"submission_hash"`
+	Required       bool                       `json:"required"`
+	Members        []string                   `json:"members"`
+	Signoffs       []models.SubmissionSignoff `json:"signoffs"`
+	Missing        []string                   `json:"missing"`
+	Complete       bool                       `json:"complete"`
+}
+
+// teamMemberAddresses returns the normalized addresses of the leader and
+// every member of a team, without duplicates.
+func teamMemberAddresses(team *models.Team) []string {
+	seen := make(map[string]bool)
+	var addresses []string
+	add := func(address string) {
+		address = normalizeAddress(address)
+		if address == "" || seen[address] {
+			return
+		}
+		seen[address] = true
+		addresses = append(addresses, address)
+	}
+
+	add(team.LeaderAddress)
+	for _, member := range team.Members {
+		add(member.Address)
+	}
+	return addresses
+}
+
+// missingSignoffs lists the members without a signoff among signoffs.
+func missingSignoffs(members []string, signoffs []models.SubmissionSignoff) []string {
+	signed := make(map[string]bool, len(signoffs))
+	for _, signoff := range signoffs {
+		signed[signoff.MemberAddress] = true
+	}
+
+	missing := []string{}
+	for _, member := range members {
+		if !signed[member] {
+			missing = append(missing, member)
+		}
+	}
+	return missing
+}
+
+// submissionEditable reports whether the team may still change a submission.
+func submissionEditable(status models.SubmissionStatus) bool {
+	switch status {
+	case models.SubmissionStatusDraft, models.SubmissionStatusAwaitingSignoff, models.SubmissionStatusPending,
+		models.SubmissionStatusChangesRequested:
+		return true
+	}
+	return false
+}
+
+// submissionFinalized reports whether a submission has left the team's
+// draft workflow and counts for review, voting and anchoring. Submissions
+// sent back for changes or withdrawn do not count.
+func submissionFinalized(status models.SubmissionStatus) bool {
+	switch status {
+	case models.SubmissionStatusDraft, models.SubmissionStatusAwaitingSignoff,
+		models.SubmissionStatusChangesRequested, models.SubmissionStatusWithdrawn:
+		return false
+	}
+	return true
+}
@@ -0,0 +1,77 @@
+package services
+
+import (
+	"errors"
+	"hackathon-platform/backend/models"
+	"time"
+)
+
+var (
+	errSubmissionNotOpen = errors.New("submission window has not opened yet")
+	errSubmissionClosed  = errors.New("submission deadline has passed")
+)
+
+func submissionGrace(event *models.Event) time.Duration {
+	return time.Duration(event.SubmissionGraceMins) * time.Minute
+}
+
+// teamDeadline is the on-time deadline for a team: its extension if it has
+// one, otherwise the event deadline. Nil means there is no deadline.
+func teamDeadline(event *models.Event, extension *models.SubmissionDeadlineExtension) *time.Time {
+	if extension != nil {
+		return &extension.ExtendedUntil
+	}
+	return event.SubmissionEndTime
+}
+
+// teamCutoff is the moment a team can no longer change its submission: its
+// deadline plus the event grace period.
+func teamCutoff(event *models.Event, extension *models.SubmissionDeadlineExtension) (time.Time, bool) {
+	deadline := teamDeadline(event, extension)
+	if deadline == nil {
+		return time.Time{}, false
+	}
+	return deadline.Add(submissionGrace(event)), true
+}
+
+// checkSubmissionWindow reports whether a team may submit at now, and
+// whether doing so counts as late.
+func checkSubmissionWindow(event *models.Event, extension *models.SubmissionDeadlineExtension, now time.Time) (bool, error) {
+	if event.SubmissionStartTime != nil && now.Before(*event.SubmissionStartTime) {
+		return false, errSubmissionNotOpen
+	}
+
+	deadline := teamDeadline(event, extension)
+	if deadline == nil || !now.After(*deadline) {
+		return false, nil
+	}
+	if cutoff, _ := teamCutoff(event, extension); !now.After(cutoff) {
+		return true, nil
+	}
+	return false, errSubmissionClosed
+}
+
+// activeExtensions keeps the most recent extension per team from a list
+// ordered by creation time.
+func activeExtensions(extensions []models.SubmissionDeadlineExtension) map[uint]*models.SubmissionDeadlineExtension {
+	active := make(map[uint]*models.SubmissionDeadlineExtension, len(extensions))
+	for i := range extensions {
+		active[extensions[i].TeamID] = &extensions[i]
+	}
+	return active
+}
+
+// eventSubmissionCutoff is when every team's window, including extensions
+// and grace, has closed.
+func eventSubmissionCutoff(event *models.Event, extensions []models.SubmissionDeadlineExtension) (time.Time, bool) {
+	cutoff, ok := teamCutoff(event, nil)
+	if !ok {
+		return time.Time{}, false
+	}
+	for _, extension := range activeExtensions(extensions) {
+		if c, _ := teamCutoff(event, extension); c.After(cutoff) {
+			cutoff = c
+		}
+	}
+	return cutoff, true
+}
@@ -0,0 +1,213 @@
+package services
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hackathon-platform/backend/models"
+	"hackathon-platform/backend/repositories"
+	"hackathon-platform/backend/storage"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	ErrUploadTooLarge      = errors.New("file exceeds the upload size limit for this event")
+	ErrUploadTypeForbidden = errors.New("file type is not allowed for this event")
+	ErrUploadEmpty         = errors.New("file is empty")
+)
+
+type UploadService interface {
+	Upload(eventID uint, req *UploadRequest, content io.Reader) (*models.UploadedFile, error)
+	ListByEvent(eventID uint) ([]models.UploadedFile, error)
+	Open(cid string) (io.ReadCloser, *models.UploadedFile, error)
+}
+
+type uploadService struct {
+	uploadRepo   repositories.UploadedFileRepository
+	eventRepo    repositories.EventRepository
+	store        storage.BlobStore
+	defaultLimit int64
+}
+
+func NewUploadService(
+	uploadRepo repositories.UploadedFileRepository,
+	eventRepo repositories.EventRepository,
+	store storage.BlobStore,
+	defaultLimit int64,
+) UploadService {
+	return &uploadService{
+		uploadRepo:   uploadRepo,
+		eventRepo:    eventRepo,
+		store:        store,
+		defaultLimit: defaultLimit,
+	}
+}
+
+// UploadRequest describes an uploaded file. Only the name is taken from the
+// client; digest, size and content type are computed server-side.
+type UploadRequest struct {
+	FileName   string
+	UploadedBy string
+}
+
+// UploadURL is the public download path for a stored blob.
+func UploadURL(cid string) string {
+	return "/api/v1/uploads/" + cid
+}
+
+func (s *uploadService) Upload(eventID uint, req *UploadRequest, content io.Reader) (*models.UploadedFile, error) {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+	if strings.TrimSpace(req.UploadedBy) == "" {
+		return nil, errors.New("uploaded_by is required")
+	}
+
+	limit := s.defaultLimit
+	if event.MaxUploadBytes > 0 {
+		limit = event.MaxUploadBytes
+	}
+
+	// Stream to a scratch file while hashing so memory use stays flat
+	tmp, err := s.store.TempFile()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}()
+
+	hasher := sha256.New()
+	cidBuilder := storage.NewCIDBuilder()
+	size, err := io.Copy(io.MultiWriter(tmp, hasher, cidBuilder), io.LimitReader(content, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if size > limit {
+		return nil, ErrUploadTooLarge
+	}
+	if size == 0 {
+		return nil, ErrUploadEmpty
+	}
+
+	fileName := filepath.Base(strings.TrimSpace(req.FileName))
+	contentType, err := detectContentType(tmp, fileName)
+	if err != nil {
+		return nil, err
+	}
+	if !uploadTypeAllowed(event.AllowedUploadTypes, contentType, fileName) {
+		return nil, ErrUploadTypeForbidden
+	}
+
+	digest := hasher.Sum(nil)
+	cid := cidBuilder.CID()
+
+	if existing, err := s.uploadRepo.GetByEventAndCID(eventID, cid); err == nil {
+		return existing, nil
+	}
+
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	if err := s.store.Put(cid, tmp); err != nil {
+		return nil, err
+	}
+
+	upload := &models.UploadedFile{
+		EventID:     eventID,
+		CID:         cid,
+		SHA256:      hex.EncodeToString(digest),
+		Size:        size,
+		ContentType: contentType,
+		FileName:    fileName,
+		UploadedBy:  req.UploadedBy,
+	}
+	if err := s.uploadRepo.Create(upload); err != nil {
+		return nil, err
+	}
+
+	return upload, nil
+}
+
+func (s *uploadService) ListByEvent(eventID uint) ([]models.UploadedFile, error) {
+	return s.uploadRepo.ListByEvent(eventID)
+}
+
+func (s *uploadService) Open(cid string) (io.ReadCloser, *models.UploadedFile, error) {
+	if !storage.IsCID(cid) {
+		return nil, nil, errors.New("invalid CID")
+	}
+	upload, err := s.uploadRepo.GetByCID(cid)
+	if err != nil {
+		return nil, nil, err
+	}
+	reader, err := s.store.Open(cid)
+	if err != nil {
+		return nil, nil, err
+	}
+	return reader, upload, nil
+}
+
+// detectContentType sniffs the stored content rather than trusting the
+// client-supplied header. Unrecognised binary content falls back to the
+// type registered for the file extension.
+func detectContentType(f *os.File, fileName string) (string, error) {
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	head := make([]byte, 512)
+	n, err := io.ReadFull(f, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", err
+	}
+
+	detected := http.DetectContentType(head[:n])
+	mediaType, _, err := mime.ParseMediaType(detected)
+	if err != nil {
+		return "", fmt.Errorf("cannot determine content type: %w", err)
+	}
+	if mediaType == "application/octet-stream" {
+		if byExt := mime.TypeByExtension(strings.ToLower(filepath.Ext(fileName))); byExt != "" {
+			if parsed, _, err := mime.ParseMediaType(byExt); err == nil {
+				mediaType = parsed
+			}
+		}
+	}
+	return mediaType, nil
+}
+
+// uploadTypeAllowed matches against a comma-separated allow list of exact
+// MIME types, wildcard families such as image/*, or extensions such as .pdf.
+func uploadTypeAllowed(allowed, contentType, fileName string) bool {
+	if strings.TrimSpace(allowed) == "" {
+		return true
+	}
+
+	ext := strings.ToLower(filepath.Ext(fileName))
+	for _, entry := range strings.Split(allowed, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		switch {
+		case entry == "":
+			continue
+		case strings.HasPrefix(entry, "."):
+			if entry == ext {
+				return true
+			}
+		case strings.HasSuffix(entry, "/*"):
+			if strings.HasPrefix(contentType, strings.TrimSuffix(entry, "*")) {
+				return true
+			}
+		case entry == contentType:
+			return true
+		}
+	}
+	return false
+}
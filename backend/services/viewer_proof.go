@@ -0,0 +1,46 @@
+package services
+
+import (
+	"fmt"
+	"hackathon-platform/backend/models"
+	"time"
+)
+
+const (
+	// viewerProofMaxAge bounds how long a signed viewer proof can be replayed.
+	viewerProofMaxAge = 10 * time.Minute
+	// viewerProofClockSkew is how far in the future a proof's timestamp may
+	// be, to tolerate drift on the organizer's device.
+	viewerProofClockSkew = time.Minute
+)
+
+// ViewerProof is a personal_sign signature over ViewerProofMessage. It is
+// how an organizer reads tallies that are hidden from everyone else; a bare
+// address is public and proves nothing.
+type ViewerProof struct {
+	Address   string
+	Signature string
+	Timestamp int64 // Unix seconds, part of the signed message
+}
+
+// ViewerProofMessage is the message an organizer signs to view hidden
+// tallies of an event.
+func ViewerProofMessage(eventID uint, timestamp int64) string {
+	return fmt.Sprintf("View hidden tallies for event %d\nTimestamp: %d", eventID, timestamp)
+}
+
+// isOrganizerViewer reports whether proof is a recent signature by the
+// event organizer. A nil proof is an anonymous viewer.
+func isOrganizerViewer(event *models.Event, proof *ViewerProof) bool {
+	if proof == nil || proof.Signature == "" {
+		return false
+	}
+	if normalizeAddress(proof.Address) != normalizeAddress(event.OrganizerAddress) {
+		return false
+	}
+	age := time.Since(time.Unix(proof.Timestamp, 0))
+	if age > viewerProofMaxAge || age < -viewerProofClockSkew {
+		return false
+	}
+	return verifyPersonalSignature(event.OrganizerAddress, ViewerProofMessage(event.ID, proof.Timestamp), proof.Signature) == nil
+}
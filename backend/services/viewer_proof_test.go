@@ -0,0 +1,36 @@
+package services
+
+import (
+	"hackathon-platform/backend/models"
+	"testing"
+	"time"
+)
+
+func TestOrganizerViewerProof(t *testing.T) {
+	organizer := newTestWallet(t)
+	event := &models.Event{ID: 3, OrganizerAddress: organizer.address}
+	proof := func(signer *testWallet, eventID uint, signedAt time.Time) *ViewerProof {
+		return &ViewerProof{
+			Address:   organizer.address,
+			Signature: signer.sign(t, ViewerProofMessage(eventID, signedAt.Unix())),
+			Timestamp: signedAt.Unix(),
+		}
+	}
+
+	if !isOrganizerViewer(event, proof(organizer, event.ID, time.Now())) {
+		t.Fatal("fresh organizer proof was rejected")
+	}
+
+	cases := map[string]*ViewerProof{
+		"anonymous":        nil,
+		"other signer":     proof(newTestWallet(t), event.ID, time.Now()),
+		"other event":      proof(organizer, event.ID+1, time.Now()),
+		"expired":          proof(organizer, event.ID, time.Now().Add(-viewerProofMaxAge-time.Minute)),
+		"future timestamp": proof(organizer, event.ID, time.Now().Add(viewerProofClockSkew+time.Minute)),
+	}
+	for name, viewer := range cases {
+		if isOrganizerViewer(event, viewer) {
+			t.Errorf("%s: proof was accepted", name)
+		}
+	}
+}
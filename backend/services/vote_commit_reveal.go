@@ -0,0 +1,279 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"hackathon-platform/backend/models"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"gorm.io/gorm"
+)
+
+// Commit-reveal phases of an event.
+const (
+	CommitPhase   = "commit"
+	RevealPhase   = "reveal"
+	CompletePhase = "complete"
+)
+
+var commitmentPattern = regexp.MustCompile(`^0x[0-9a-f]{64}$`)
+
+// CommitVoteRequest submits the hash of a vote during the voting window.
+// The hash is keccak256 of "eventId:submissionId:votes:voterAddress:salt"
+// with the lowercase voter address and votes set to the quadratic votes of
+// a public vote, or 0.
+type CommitVoteRequest struct {
+	EventID      uint             `json:"event_id" binding:"required"`
+	VoterAddress string           `json:"voter_address" binding:"required"`
+	VoterType    models.VoterType `json:"voter_type" binding:"required"`
+	Commitment   string           `json:"commitment" binding:"required"`
+	Signature    string           `json:"signature"` // EIP-712 signature over the commitment typed data
+	Nonce        uint64           `json:"nonce"`
+	ChainID      int64            `json:"chain_id"`
+}
+
+// RevealVoteRequest opens a commitment after voting closes. The vote is
+// recorded only if the choice and salt hash to one of the voter's
+// commitments.
+type RevealVoteRequest struct {
+	EventID       uint             `json:"event_id" binding:"required"`
+	SubmissionID  uint             `json:"submission_id" binding:"required"`
+	VoterAddress  string           `json:"voter_address" binding:"required"`
+	VoterType     models.VoterType `json:"voter_type" binding:"required"`
+	Votes         int              `json:"votes"`
+	Salt          string           `json:"salt" binding:"required"`
+	Reason        string           `json:"reason"`
+	Signature     string           `json:"signature" binding:"required"` // EIP-712 signature over the revealed vote
+	Nonce         uint64           `json:"nonce"`
+	ChainID       int64            `json:"chain_id"`
+	OffchainProof string           `json:"offchain_proof"` // Personhood proof when the event requires one of public voters
+}
+
+// CommitStatus reports an event's commit-reveal progress and, when a voter
+// is given, that voter's commitments.
+type CommitStatus struct {
+	EventID       uint                    `json:"event_id"`
+	Phase         string                  `json:"phase"`
+	VotingEndTime *time.Time              `json:"voting_end_time"`
+	RevealEndTime *time.Time              `json:"reveal_end_time"`
+	Committed     int64                   `json:"committed"`
+	Revealed      int64                   `json:"revealed"`
+	Commitments   []models.VoteCommitment `json:"commitments,omitempty"`
+}
+
+// CommitVote stores a vote commitment while voting is open. The voter has
+// to sign it, so nobody else can use up the voter's commitment slots.
+// Eligibility and vote limits are checked when the vote is revealed.
+func (s *voteService) CommitVote(req *CommitVoteRequest) (*models.VoteCommitment, error) {
+	event, address, commitment, err := s.checkCommitment(req)
+	if err != nil {
+		return nil, err
+	}
+
+	typedData := commitmentTypedData(req.ChainID, event.ID, address, req.VoterType, commitment, req.Nonce)
+	if _, err := consumeSignedTypedData(s.nonceRepo, "commitment", typedData, event.ID, address, req.Signature, req.Nonce); err != nil {
+		return nil, err
+	}
+
+	record := &models.VoteCommitment{
+		EventID:      event.ID,
+		VoterAddress: address,
+		VoterType:    req.VoterType,
+		Commitment:   commitment,
+		Signature:    req.Signature,
+		Nonce:        req.Nonce,
+	}
+	if err := s.commitmentRepo.Create(record); err != nil {
+		if strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "unique") {
+			return nil, errors.New("commitment already submitted")
+		}
+		return nil, err
+	}
+	return record, nil
+}
+
+// PrepareCommitment runs the same checks as CommitVote and returns the
+// typed data the voter must sign. Nothing is recorded.
+func (s *voteService) PrepareCommitment(req *CommitVoteRequest) (*SignedTypedData, error) {
+	event, address, commitment, err := s.checkCommitment(req)
+	if err != nil {
+		return nil, err
+	}
+	return prepareSignedTypedData(s.nonceRepo, event.ID, address, req.ChainID, func(nonce uint64) apitypes.TypedData {
+		return commitmentTypedData(req.ChainID, event.ID, address, req.VoterType, commitment, nonce)
+	})
+}
+
+// checkCommitment validates a commitment and the voter's remaining
+// commitment slots. It returns the normalized address and commitment.
+func (s *voteService) checkCommitment(req *CommitVoteRequest) (*models.Event, string, string, error) {
+	address := normalizeAddress(req.VoterAddress)
+	if address == "" {
+		return nil, "", "", errors.New("invalid voter address")
+	}
+	commitment := strings.ToLower(strings.TrimSpace(req.Commitment))
+	if !commitmentPattern.MatchString(commitment) {
+		return nil, "", "", errors.New("commitment must be a 0x-prefixed 32-byte hex hash")
+	}
+
+	event, err := s.eventRepo.GetByID(req.EventID)
+	if err != nil {
+		return nil, "", "", errors.New("event not found")
+	}
+	if !event.CommitReveal {
+		return nil, "", "", errors.New("this event does not use commit-reveal voting")
+	}
+	if err := checkVotingOpen(event, time.Now()); err != nil {
+		return nil, "", "", err
+	}
+	switch req.VoterType {
+	case models.VoterTypeJudge, models.VoterTypeSponsor, models.VoterTypePublic:
+	default:
+		return nil, "", "", errors.New("unsupported voter type")
+	}
+
+	// A voter cannot hold more commitments than there are submissions to vote on
+	submissions, err := s.submissionRepo.GetByEventID(event.ID)
+	if err != nil {
+		return nil, "", "", err
+	}
+	count, err := s.commitmentRepo.CountByVoter(event.ID, address)
+	if err != nil {
+		return nil, "", "", err
+	}
+	if count >= int64(len(submissions)) {
+		return nil, "", "", errors.New("commitment limit reached for this event")
+	}
+	return event, address, commitment, nil
+}
+
+// RevealVote checks the revealed choice against the voter's commitment and
+// records the vote with the usual eligibility rules.
+func (s *voteService) RevealVote(req *RevealVoteRequest) (*models.Vote, error) {
+	address := normalizeAddress(req.VoterAddress)
+	if address == "" {
+		return nil, errors.New("invalid voter address")
+	}
+
+	event, err := s.eventRepo.GetByID(req.EventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+	if !event.CommitReveal {
+		return nil, errors.New("this event does not use commit-reveal voting")
+	}
+	if phase := commitRevealPhase(event, time.Now()); phase != RevealPhase {
+		if phase == CommitPhase {
+			return nil, errors.New("votes can only be revealed after voting closes")
+		}
+		return nil, errors.New("the reveal window has closed")
+	}
+
+	hash := voteCommitment(event.ID, req.SubmissionID, req.Votes, address, req.Salt)
+	commitment, err := s.commitmentRepo.GetByCommitment(event.ID, address, hash)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("revealed vote does not match any commitment")
+		}
+		return nil, err
+	}
+	if commitment.Revealed {
+		return nil, errors.New("commitment already revealed")
+	}
+	if commitment.VoterType != req.VoterType {
+		return nil, errors.New("voter type does not match the commitment")
+	}
+
+	vote, err := s.recordVote(&CastVoteRequest{
+		EventID:       event.ID,
+		SubmissionID:  req.SubmissionID,
+		VoterAddress:  address,
+		VoterType:     req.VoterType,
+		Reason:        req.Reason,
+		Signature:     req.Signature,
+		Nonce:         req.Nonce,
+		ChainID:       req.ChainID,
+		OffchainProof: req.OffchainProof,
+		Votes:         req.Votes,
+	}, event, address)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.commitmentRepo.MarkRevealed(commitment.ID, vote.ID, time.Now()); err != nil {
+		return nil, err
+	}
+	return vote, nil
+}
+
+func (s *voteService) GetCommitStatus(eventID uint, voterAddress string) (*CommitStatus, error) {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	if !event.CommitReveal {
+		return nil, errors.New("this event does not use commit-reveal voting")
+	}
+
+	committed, revealed, err := s.commitmentRepo.CountByEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+	status := &CommitStatus{
+		EventID:       eventID,
+		Phase:         commitRevealPhase(event, time.Now()),
+		VotingEndTime: event.VotingEndTime,
+		RevealEndTime: event.RevealEndTime,
+		Committed:     committed,
+		Revealed:      revealed,
+	}
+	if address := normalizeAddress(voterAddress); address != "" {
+		status.Commitments, err = s.commitmentRepo.ListByVoter(eventID, address)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return status, nil
+}
+
+// voteCommitment computes the hash a voter commits to.
+func voteCommitment(eventID uint, submissionID uint, votes int, address string, salt string) string {
+	preimage := fmt.Sprintf("%d:%d:%d:%s:%s", eventID, submissionID, votes, normalizeAddress(address), salt)
+	return crypto.Keccak256Hash([]byte(preimage)).Hex()
+}
+
+// commitRevealPhase reports where a commit-reveal event stands: commits are
+// taken until VotingEndTime, reveals until RevealEndTime.
+func commitRevealPhase(event *models.Event, now time.Time) string {
+	if event.VotingEndTime == nil || !now.After(*event.VotingEndTime) {
+		return CommitPhase
+	}
+	if event.RevealEndTime != nil && now.After(*event.RevealEndTime) {
+		return CompletePhase
+	}
+	return RevealPhase
+}
+
+// votesSealed reports whether a commit-reveal event is still before the end
+// of its reveal window, when votes and tallies stay hidden.
+func votesSealed(event *models.Event, now time.Time) bool {
+	return event.CommitReveal && commitRevealPhase(event, now) != CompletePhase
+}
+
+// validateCommitReveal checks that a commit-reveal event has a reveal window
+// after voting closes.
+func validateCommitReveal(event *models.Event) error {
+	if !event.CommitReveal {
+		return nil
+	}
+	if event.VotingEndTime == nil || event.RevealEndTime == nil {
+		return errors.New("commit-reveal voting requires voting and reveal end times")
+	}
+	if !event.RevealEndTime.After(*event.VotingEndTime) {
+		return errors.New("reveal end time must be after voting end time")
+	}
+	return nil
+}
@@ -5,26 +5,49 @@ import (
 	"fmt"
 	"hackathon-platform/backend/models"
 	"hackathon-platform/backend/repositories"
+	"math"
 	"strings"
 	"time"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 )
 
 const (
-	maxPublicVotesPerEvent = 3
+	maxPublicVotesPerEvent   = 3
+	defaultPublicVoteCredits = 100
 )
 
+// ErrTalliesHidden is returned when vote tallies are withheld from the viewer.
+var ErrTalliesHidden = errors.New("vote tallies are hidden until results are released")
+
 // VoteService exposes the voting use cases.
 type VoteService interface {
 	CastVote(req *CastVoteRequest) (*models.Vote, error)
-	ListVotesByEvent(eventID uint) ([]models.Vote, error)
-	ListVotesBySubmission(submissionID uint) ([]models.Vote, error)
+	PrepareVote(req *CastVoteRequest) (*VoteTypedData, error)
+	VerifyVote(id uint) (*VoteVerification, error)
+	ListVotesByEvent(eventID uint, viewer *ViewerProof) ([]models.Vote, error)
+	ListVotesBySubmission(submissionID uint, viewer *ViewerProof) ([]models.Vote, error)
 	GetVote(id uint) (*models.Vote, error)
 	DeleteVote(id uint, organizerAddress string) error
-	GetEventSummary(eventID uint) ([]VoteSummary, error)
+	GetEventSummary(eventID uint, viewer *ViewerProof) ([]VoteSummary, error)
+	BuildEventSummary(event *models.Event) ([]VoteSummary, error)
 
 	AddJudge(eventID uint, req *AddJudgeRequest) (*models.EventJudge, error)
 	ListJudges(eventID uint) ([]models.EventJudge, error)
 	RemoveJudge(eventID uint, judgeID uint, organizerAddress string) error
+
+	GetRubric(eventID uint) ([]models.RubricCriterion, error)
+	SetRubric(eventID uint, req *SetRubricRequest) ([]models.RubricCriterion, error)
+	SubmitScorecard(req *SubmitScorecardRequest) ([]models.RubricScore, error)
+	PrepareScorecard(req *SubmitScorecardRequest) (*SignedTypedData, error)
+	ListScorecards(submissionID uint, viewer *ViewerProof) ([]models.RubricScore, error)
+	GetVoteCredits(eventID uint, voterAddress string) (*VoteCredits, error)
+
+	CommitVote(req *CommitVoteRequest) (*models.VoteCommitment, error)
+	PrepareCommitment(req *CommitVoteRequest) (*SignedTypedData, error)
+	RevealVote(req *RevealVoteRequest) (*models.Vote, error)
+	GetCommitStatus(eventID uint, voterAddress string) (*CommitStatus, error)
+	GetCalibration(eventID uint, organizerAddress string) (*CalibrationReport, error)
 }
 
 type voteService struct {
@@ -32,8 +55,17 @@ type voteService struct {
 	eventRepo       repositories.EventRepository
 	submissionRepo  repositories.SubmissionRepository
 	eventJudgeRepo  repositories.EventJudgeRepository
+	rubricRepo      repositories.RubricRepository
+	assignmentRepo  repositories.JudgeAssignmentRepository
+	conflictRepo    repositories.JudgeConflictRepository
+	commitmentRepo  repositories.VoteCommitmentRepository
+	nonceRepo       repositories.VoteNonceRepository
 	sponsorRepo     repositories.SponsorRepository
 	sponsorshipRepo repositories.SponsorshipRepository
+	sponsorRuleRepo repositories.SponsorVotingRuleRepository
+	publicVoters    PublicVoterService
+	attendance      AttendanceService
+	live            LiveNotifier
 }
 
 func NewVoteService(
@@ -41,16 +73,34 @@ func NewVoteService(
 	eventRepo repositories.EventRepository,
 	submissionRepo repositories.SubmissionRepository,
 	eventJudgeRepo repositories.EventJudgeRepository,
+	rubricRepo repositories.RubricRepository,
+	assignmentRepo repositories.JudgeAssignmentRepository,
+	conflictRepo repositories.JudgeConflictRepository,
+	commitmentRepo repositories.VoteCommitmentRepository,
+	nonceRepo repositories.VoteNonceRepository,
 	sponsorRepo repositories.SponsorRepository,
 	sponsorshipRepo repositories.SponsorshipRepository,
+	sponsorRuleRepo repositories.SponsorVotingRuleRepository,
+	publicVoters PublicVoterService,
+	attendance AttendanceService,
+	live LiveNotifier,
 ) VoteService {
 	return &voteService{
 		voteRepo:        voteRepo,
 		eventRepo:       eventRepo,
 		submissionRepo:  submissionRepo,
 		eventJudgeRepo:  eventJudgeRepo,
+		rubricRepo:      rubricRepo,
+		assignmentRepo:  assignmentRepo,
+		conflictRepo:    conflictRepo,
+		commitmentRepo:  commitmentRepo,
+		nonceRepo:       nonceRepo,
 		sponsorRepo:     sponsorRepo,
 		sponsorshipRepo: sponsorshipRepo,
+		sponsorRuleRepo: sponsorRuleRepo,
+		publicVoters:    publicVoters,
+		attendance:      attendance,
+		live:            live,
 	}
 }
 
@@ -61,9 +111,11 @@ type CastVoteRequest struct {
 	VoterAddress  string           `json:"voter_address" binding:"required"`
 	VoterType     models.VoterType `json:"voter_type" binding:"required"`
 	Reason        string           `json:"reason"`
-	Signature     string           `json:"signature"`
+	Signature     string           `json:"signature"` // EIP-712 signature over the typed data returned by PrepareVote
+	Nonce         uint64           `json:"nonce"`
+	ChainID       int64            `json:"chain_id"` // Chain ID of the signing domain
 	OffchainProof string           `json:"offchain_proof"`
-	Weight        *float64         `json:"weight"`
+	Votes         int              `json:"votes"` // Quadratic public votes for the submission, costing votes² credits
 }
 
 // VoteSummary represents aggregated scores for a submission.
@@ -74,7 +126,36 @@ type VoteSummary struct {
 	JudgeWeight     float64 `json:"judge_weight"`
 	SponsorWeight   float64 `json:"sponsor_weight"`
 	PublicWeight    float64 `json:"public_weight"`
+	PublicCredits   int64   `json:"public_credits"` // Credits spent by quadratic public voters
 	VoteCount       int64   `json:"vote_count"`
+	// Rubric scores: the sum and mean of the judges' weighted scorecards,
+	// and the combined score under the event's normalization
+	RubricTotal         float64                   `json:"rubric_total"`
+	RubricAverage       float64                   `json:"rubric_average"`
+	RubricNormalized    float64                   `json:"rubric_normalized"`
+	RubricNormalization models.ScoreNormalization `json:"rubric_normalization,omitempty"`
+	RubricJudgeCount    int                       `json:"rubric_judge_count"`
+	RubricCriteria      []CriterionAverage        `json:"rubric_criteria,omitempty"`
+	// AwardEligible is false when the team misses a required attendance session
+	AwardEligible     bool     `json:"award_eligible"`
+	EligibilityIssues []string `json:"eligibility_issues,omitempty"`
+}
+
+// VoteCredits is a public voter's quadratic voting budget for an event.
+type VoteCredits struct {
+	EventID     uint                    `json:"event_id"`
+	Mode        models.PublicVotingMode `json:"mode"`
+	Budget      int                     `json:"budget"`
+	Spent       int                     `json:"spent"`
+	Remaining   int                     `json:"remaining"`
+	Allocations []CreditAllocation      `json:"allocations"`
+}
+
+// CreditAllocation is the votes a public voter gave one submission.
+type CreditAllocation struct {
+	SubmissionID uint `json:"submission_id"`
+	Votes        int  `json:"votes"`
+	Credits      int  `json:"credits"`
 }
 
 // AddJudgeRequest contains judge assignment payload.
@@ -100,29 +181,26 @@ func (s *voteService) CastVote(req *CastVoteRequest) (*models.Vote, error) {
 		return nil, errors.New("event not found")
 	}
 
-	if event.CurrentStage != models.StageVoting {
-		return nil, errors.New("event is not in voting stage")
+	if event.CommitReveal {
+		return nil, errors.New("this event uses commit-reveal voting; submit a commitment instead")
 	}
 
-	now := time.Now()
-	if event.VotingStartTime != nil && now.Before(*event.VotingStartTime) {
-		return nil, errors.New("voting has not started yet")
+	if err := checkVotingOpen(event, time.Now()); err != nil {
+		return nil, err
 	}
 
-	if event.VotingEndTime != nil && now.After(*event.VotingEndTime) {
-		return nil, errors.New("voting has already ended")
-	}
+	return s.recordVote(req, event, address)
+}
 
-	submission, err := s.submissionRepo.GetByID(req.SubmissionID)
+// recordVote validates the submission, voter and signature and stores the
+// vote. It is shared by direct votes and commit-reveal reveals.
+func (s *voteService) recordVote(req *CastVoteRequest, event *models.Event, address string) (*models.Vote, error) {
+	submission, weight, err := s.voteWeight(req, event, address)
 	if err != nil {
-		return nil, errors.New("submission not found")
-	}
-
-	if submission.EventID != event.ID {
-		return nil, errors.New("submission does not belong to this event")
+		return nil, err
 	}
 
-	weight, err := s.calculateWeight(req, event, address)
+	payload, err := s.verifyVoteSignature(req, event, submission, address, weight)
 	if err != nil {
 		return nil, err
 	}
@@ -133,22 +211,70 @@ func (s *voteService) CastVote(req *CastVoteRequest) (*models.Vote, error) {
 		VoterAddress:  address,
 		VoterType:     req.VoterType,
 		Weight:        weight,
+		Credits:       voteCredits(req, event),
 		Reason:        req.Reason,
 		Signature:     req.Signature,
+		SignedPayload: payload,
+		Nonce:         req.Nonce,
 		OffchainProof: req.OffchainProof,
 	}
 
-	if err := s.voteRepo.Create(vote); err != nil {
+	if err := s.createVote(vote, event); err != nil {
 		if strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "unique") {
 			return nil, errors.New("you already voted for this submission")
 		}
 		return nil, err
 	}
 
+	notifyLive(s.live, vote.EventID, LiveTopicVotes)
 	return vote, nil
 }
 
-func (s *voteService) calculateWeight(req *CastVoteRequest, event *models.Event, address string) (float64, error) {
+// createVote stores vote. Quadratic votes are charged against the voter's
+// budget in the same transaction as the insert; the check in
+// quadraticWeight only gives an early, more detailed error.
+func (s *voteService) createVote(vote *models.Vote, event *models.Event) error {
+	if vote.Credits == 0 {
+		return s.voteRepo.Create(vote)
+	}
+	created, err := s.voteRepo.CreateWithinBudget(vote, publicVoteBudget(event))
+	if err != nil {
+		return err
+	}
+	if !created {
+		return fmt.Errorf("%d votes cost %d credits, more than remain of the %d credit budget", int(vote.Weight), vote.Credits, publicVoteBudget(event))
+	}
+	return nil
+}
+
+// voteWeight loads the submission being voted on and works out the weight
+// the vote would be recorded with.
+func (s *voteService) voteWeight(req *CastVoteRequest, event *models.Event, address string) (*models.Submission, float64, error) {
+	submission, err := s.submissionRepo.GetByID(req.SubmissionID)
+	if err != nil {
+		return nil, 0, errors.New("submission not found")
+	}
+
+	if submission.EventID != event.ID {
+		return nil, 0, errors.New("submission does not belong to this event")
+	}
+
+	if !submissionFinalized(submission.Status) {
+		return nil, 0, errors.New("submission has not been finalized by the team")
+	}
+
+	weight, err := s.calculateWeight(req, event, submission, address)
+	if err != nil {
+		return nil, 0, err
+	}
+	return submission, weight, nil
+}
+
+func (s *voteService) calculateWeight(req *CastVoteRequest, event *models.Event, submission *models.Submission, address string) (float64, error) {
+	if err := s.checkConflict(event.ID, submission, address); err != nil {
+		return 0, err
+	}
+
 	switch req.VoterType {
 	case models.VoterTypeJudge:
 		judge, err := s.eventJudgeRepo.GetByEventAndAddress(event.ID, address)
@@ -158,6 +284,9 @@ func (s *voteService) calculateWeight(req *CastVoteRequest, event *models.Event,
 		if judge.Weight <= 0 {
 			return 0, errors.New("judge weight must be greater than zero")
 		}
+		if err := s.checkJudgeAssigned(event.ID, req.SubmissionID, address); err != nil {
+			return 0, err
+		}
 		count, err := s.voteRepo.CountByEventAndVoter(event.ID, address, models.VoterTypeJudge)
 		if err != nil {
 			return 0, err
@@ -178,23 +307,22 @@ func (s *voteService) calculateWeight(req *CastVoteRequest, event *models.Event,
 		if err != nil {
 			return 0, err
 		}
-		var totalPower float64
-		for _, sship := range sponsorships {
-			if sship.Status != models.SponsorshipStatusApproved && sship.Status != models.SponsorshipStatusDeposited {
-				continue
-			}
-			if sship.VotingPower > 0 {
-				totalPower += sship.VotingPower
-			}
+		rules, err := s.sponsorRuleRepo.ListByEvent(event.ID)
+		if err != nil {
+			return 0, err
 		}
-		if totalPower == 0 {
-			totalPower = 1
+		power := sponsorVotingPower(*sponsor, sponsorships, rules)
+		if power.Power <= 0 {
+			return 0, errors.New("sponsor has no deposited voting power for this event")
 		}
-		return totalPower, nil
+		return power.Power, nil
 	case models.VoterTypePublic:
 		if !event.AllowPublicVoting {
 			return 0, errors.New("public voting is disabled for this event")
 		}
+		if err := s.publicVoters.CheckVoter(event, address, req.OffchainProof); err != nil {
+			return 0, err
+		}
 		count, err := s.voteRepo.CountBySubmissionAndVoter(req.SubmissionID, address, models.VoterTypePublic)
 		if err != nil {
 			return 0, err
@@ -202,6 +330,9 @@ func (s *voteService) calculateWeight(req *CastVoteRequest, event *models.Event,
 		if count > 0 {
 			return 0, errors.New("public voters can only vote once per submission")
 		}
+		if event.PublicVotingMode == models.PublicVotingQuadratic {
+			return s.quadraticWeight(req, event, address)
+		}
 		eventCount, err := s.voteRepo.CountByEventAndVoter(event.ID, address, models.VoterTypePublic)
 		if err != nil {
 			return 0, err
@@ -209,20 +340,31 @@ func (s *voteService) calculateWeight(req *CastVoteRequest, event *models.Event,
 		if eventCount >= maxPublicVotesPerEvent {
 			return 0, fmt.Errorf("public voters can only vote %d times per event", maxPublicVotesPerEvent)
 		}
-		if req.Weight != nil && *req.Weight > 0 {
-			return *req.Weight, nil
-		}
 		return 1, nil
 	default:
 		return 0, errors.New("unsupported voter type")
 	}
 }
 
-func (s *voteService) ListVotesByEvent(eventID uint) ([]models.Vote, error) {
+func (s *voteService) ListVotesByEvent(eventID uint, viewer *ViewerProof) ([]models.Vote, error) {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	if votesSealed(event, time.Now()) && !isOrganizerViewer(event, viewer) {
+		return nil, ErrTalliesHidden
+	}
 	return s.voteRepo.GetByEventID(eventID)
 }
 
-func (s *voteService) ListVotesBySubmission(submissionID uint) ([]models.Vote, error) {
+func (s *voteService) ListVotesBySubmission(submissionID uint, viewer *ViewerProof) ([]models.Vote, error) {
+	submission, err := s.submissionRepo.GetByID(submissionID)
+	if err != nil {
+		return nil, err
+	}
+	if votesSealed(&submission.Event, time.Now()) && !isOrganizerViewer(&submission.Event, viewer) {
+		return nil, ErrTalliesHidden
+	}
 	return s.voteRepo.GetBySubmissionID(submissionID)
 }
 
@@ -245,26 +387,98 @@ func (s *voteService) DeleteVote(id uint, organizerAddress string) error {
 		return errors.New("only the organizer can delete votes")
 	}
 
-	return s.voteRepo.Delete(id)
+	if err := s.voteRepo.Delete(id); err != nil {
+		return err
+	}
+
+	notifyLive(s.live, vote.EventID, LiveTopicVotes)
+	return nil
+}
+
+func (s *voteService) GetEventSummary(eventID uint, viewer *ViewerProof) ([]VoteSummary, error) {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+	if talliesHidden(event) && !isOrganizerViewer(event, viewer) {
+		return nil, ErrTalliesHidden
+	}
+	return s.BuildEventSummary(event)
 }
 
-func (s *voteService) GetEventSummary(eventID uint) ([]VoteSummary, error) {
+// BuildEventSummary computes the event summary without checking whether the
+// tallies are hidden; callers decide who gets to see it.
+func (s *voteService) BuildEventSummary(event *models.Event) ([]VoteSummary, error) {
+	eventID := event.ID
 	rows, err := s.voteRepo.GetSummaryByEvent(eventID)
 	if err != nil {
 		return nil, err
 	}
 
+	submissions, err := s.submissionRepo.GetByEventID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	teamBySubmission := make(map[uint]uint, len(submissions))
+	for _, submission := range submissions {
+		teamBySubmission[submission.ID] = submission.TeamID
+	}
+
+	criteria, err := s.rubricRepo.ListCriteria(eventID)
+	if err != nil {
+		return nil, err
+	}
+	scores, err := s.rubricRepo.ListScoresByEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+	rubric := rubricResults(criteria, scores, event.ScoreNormalization)
+
+	// Submissions scored on the rubric but without votes are listed too
+	voted := make(map[uint]bool, len(rows))
+	for _, row := range rows {
+		voted[row.SubmissionID] = true
+	}
+	for _, submission := range submissions {
+		if _, ok := rubric[submission.ID]; ok && !voted[submission.ID] {
+			rows = append(rows, repositories.VoteSummaryRow{
+				SubmissionID:    submission.ID,
+				SubmissionTitle: submission.Title,
+			})
+		}
+	}
+
+	eligibility, err := s.attendance.GetEventEligibility(eventID)
+	if err != nil {
+		return nil, err
+	}
+
 	var summaries []VoteSummary
 	for _, row := range rows {
-		summaries = append(summaries, VoteSummary{
+		summary := VoteSummary{
 			SubmissionID:    row.SubmissionID,
 			SubmissionTitle: row.SubmissionTitle,
 			TotalWeight:     row.TotalWeight,
 			JudgeWeight:     row.JudgeWeight,
 			SponsorWeight:   row.SponsorWeight,
 			PublicWeight:    row.PublicWeight,
+			PublicCredits:   row.PublicCredits,
 			VoteCount:       row.VoteCount,
-		})
+			AwardEligible:   true,
+		}
+		if result, ok := rubric[row.SubmissionID]; ok {
+			summary.RubricTotal = result.total
+			summary.RubricAverage = result.average
+			summary.RubricNormalized = result.normalized
+			summary.RubricNormalization = event.ScoreNormalization
+			summary.RubricJudgeCount = result.judges
+			summary.RubricCriteria = result.criteria
+		}
+		if team, ok := eligibility[teamBySubmission[row.SubmissionID]]; ok {
+			summary.AwardEligible = team.Eligible
+			summary.EligibilityIssues = team.Issues
+		}
+		summaries = append(summaries, summary)
 	}
 	return summaries, nil
 }
@@ -334,6 +548,327 @@ func (s *voteService) RemoveJudge(eventID uint, judgeID uint, organizerAddress s
 	return s.eventJudgeRepo.Delete(judgeID)
 }
 
+func (s *voteService) GetRubric(eventID uint) ([]models.RubricCriterion, error) {
+	if _, err := s.eventRepo.GetByID(eventID); err != nil {
+		return nil, errors.New("event not found")
+	}
+	return s.rubricRepo.ListCriteria(eventID)
+}
+
+// SetRubric replaces the event's rubric. The rubric is locked once judges
+// have started scoring, since existing scorecards refer to its criteria.
+func (s *voteService) SetRubric(eventID uint, req *SetRubricRequest) ([]models.RubricCriterion, error) {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+	if normalizeAddress(event.OrganizerAddress) != normalizeAddress(req.OrganizerAddress) {
+		return nil, errors.New("only the organizer can manage the rubric")
+	}
+
+	scored, err := s.rubricRepo.CountScoresByEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+	if scored > 0 {
+		return nil, errors.New("rubric cannot change after judges have scored")
+	}
+
+	criteria, err := buildRubric(eventID, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.rubricRepo.ReplaceCriteria(eventID, criteria); err != nil {
+		return nil, err
+	}
+	return s.rubricRepo.ListCriteria(eventID)
+}
+
+// SubmitScorecard records a whitelisted judge's rubric scores for a
+// submission while voting is open.
+func (s *voteService) SubmitScorecard(req *SubmitScorecardRequest) ([]models.RubricScore, error) {
+	event, submission, address, scores, err := s.checkScorecard(req)
+	if err != nil {
+		return nil, err
+	}
+
+	typedData := scorecardTypedData(req.ChainID, event.ID, submission.ID, address, scores, req.Nonce)
+	payload, err := consumeSignedTypedData(s.nonceRepo, "scorecard", typedData, event.ID, address, req.Signature, req.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	for i := range scores {
+		scores[i].Signature = req.Signature
+		scores[i].SignedPayload = payload
+		scores[i].Nonce = req.Nonce
+	}
+	if err := s.rubricRepo.ReplaceScorecard(submission.ID, address, scores); err != nil {
+		return nil, err
+	}
+
+	notifyLive(s.live, event.ID, LiveTopicVotes)
+	return scores, nil
+}
+
+// PrepareScorecard runs the same checks as SubmitScorecard and returns the
+// typed data the judge must sign. Nothing is recorded.
+func (s *voteService) PrepareScorecard(req *SubmitScorecardRequest) (*SignedTypedData, error) {
+	event, submission, address, scores, err := s.checkScorecard(req)
+	if err != nil {
+		return nil, err
+	}
+	return prepareSignedTypedData(s.nonceRepo, event.ID, address, req.ChainID, func(nonce uint64) apitypes.TypedData {
+		return scorecardTypedData(req.ChainID, event.ID, submission.ID, address, scores, nonce)
+	})
+}
+
+// checkScorecard validates the judge and builds the scorecard's rows.
+func (s *voteService) checkScorecard(req *SubmitScorecardRequest) (*models.Event, *models.Submission, string, []models.RubricScore, error) {
+	address := normalizeAddress(req.JudgeAddress)
+	if address == "" {
+		return nil, nil, "", nil, errors.New("invalid judge address")
+	}
+
+	event, err := s.eventRepo.GetByID(req.EventID)
+	if err != nil {
+		return nil, nil, "", nil, errors.New("event not found")
+	}
+	if err := checkVotingOpen(event, time.Now()); err != nil {
+		return nil, nil, "", nil, err
+	}
+	if _, err := s.eventJudgeRepo.GetByEventAndAddress(event.ID, address); err != nil {
+		return nil, nil, "", nil, errors.New("address is not on the judge whitelist")
+	}
+	if err := s.checkJudgeAssigned(event.ID, req.SubmissionID, address); err != nil {
+		return nil, nil, "", nil, err
+	}
+
+	submission, err := s.submissionRepo.GetByID(req.SubmissionID)
+	if err != nil {
+		return nil, nil, "", nil, errors.New("submission not found")
+	}
+	if submission.EventID != event.ID {
+		return nil, nil, "", nil, errors.New("submission does not belong to this event")
+	}
+	if !submissionFinalized(submission.Status) {
+		return nil, nil, "", nil, errors.New("submission has not been finalized by the team")
+	}
+	if err := s.checkConflict(event.ID, submission, address); err != nil {
+		return nil, nil, "", nil, err
+	}
+
+	criteria, err := s.rubricRepo.ListCriteria(event.ID)
+	if err != nil {
+		return nil, nil, "", nil, err
+	}
+	scores, err := buildScorecard(criteria, submission, address, req.Scores)
+	if err != nil {
+		return nil, nil, "", nil, err
+	}
+	return event, submission, address, scores, nil
+}
+
+func (s *voteService) ListScorecards(submissionID uint, viewer *ViewerProof) ([]models.RubricScore, error) {
+	submission, err := s.submissionRepo.GetByID(submissionID)
+	if err != nil {
+		return nil, err
+	}
+	if talliesHidden(&submission.Event) && !isOrganizerViewer(&submission.Event, viewer) {
+		return nil, ErrTalliesHidden
+	}
+	return s.rubricRepo.ListScoresBySubmission(submissionID)
+}
+
+// GetCalibration reports how each judge's rubric scores compare with the
+// rest of the panel, so organizers can spot harsh or lenient judges.
+func (s *voteService) GetCalibration(eventID uint, organizerAddress string) (*CalibrationReport, error) {
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	if normalizeAddress(event.OrganizerAddress) != normalizeAddress(organizerAddress) {
+		return nil, errors.New("only the organizer can view judge calibration")
+	}
+
+	criteria, err := s.rubricRepo.ListCriteria(eventID)
+	if err != nil {
+		return nil, err
+	}
+	scores, err := s.rubricRepo.ListScoresByEvent(eventID)
+	if err != nil {
+		return nil, err
+	}
+	mean, stdDev, judges := calibrateJudges(rubricScorecards(criteria, scores))
+	return &CalibrationReport{
+		EventID:       eventID,
+		Normalization: event.ScoreNormalization,
+		Mean:          mean,
+		StdDev:        stdDev,
+		Judges:        judges,
+	}, nil
+}
+
+// quadraticWeight charges votes² credits against the voter's budget and
+// returns votes as the weight, so the tally counts the square root of the
+// credits spent.
+func (s *voteService) quadraticWeight(req *CastVoteRequest, event *models.Event, address string) (float64, error) {
+	if req.Votes < 1 {
+		return 0, errors.New("quadratic votes must be at least 1")
+	}
+	budget := publicVoteBudget(event)
+	// Bound votes before squaring them so the cost cannot overflow
+	if maxVotes := isqrt(budget); req.Votes > maxVotes {
+		return 0, fmt.Errorf("at most %d votes fit in the %d credit budget", maxVotes, budget)
+	}
+	cost := req.Votes * req.Votes
+	spent, err := s.voteRepo.SumCreditsByEventAndVoter(event.ID, address)
+	if err != nil {
+		return 0, err
+	}
+	if remaining := budget - int(spent); cost > remaining {
+		return 0, fmt.Errorf("%d votes cost %d credits but only %d of %d remain", req.Votes, cost, remaining, budget)
+	}
+	return float64(req.Votes), nil
+}
+
+// isqrt returns the largest integer whose square is at most n.
+func isqrt(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	root := int(math.Sqrt(float64(n)))
+	for root*root > n {
+		root--
+	}
+	for (root+1)*(root+1) <= n {
+		root++
+	}
+	return root
+}
+
+// GetVoteCredits reports how much of the quadratic budget a public voter
+// has spent and where.
+func (s *voteService) GetVoteCredits(eventID uint, voterAddress string) (*VoteCredits, error) {
+	address := normalizeAddress(voterAddress)
+	if address == "" {
+		return nil, errors.New("invalid voter address")
+	}
+	event, err := s.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event.PublicVotingMode != models.PublicVotingQuadratic {
+		return nil, errors.New("event does not use quadratic public voting")
+	}
+
+	votes, err := s.voteRepo.GetByEventAndVoter(eventID, address, models.VoterTypePublic)
+	if err != nil {
+		return nil, err
+	}
+	credits := &VoteCredits{
+		EventID:     eventID,
+		Mode:        event.PublicVotingMode,
+		Budget:      publicVoteBudget(event),
+		Allocations: []CreditAllocation{},
+	}
+	for _, vote := range votes {
+		credits.Spent += vote.Credits
+		credits.Allocations = append(credits.Allocations, CreditAllocation{
+			SubmissionID: vote.SubmissionID,
+			Votes:        int(vote.Weight),
+			Credits:      vote.Credits,
+		})
+	}
+	credits.Remaining = credits.Budget - credits.Spent
+	if credits.Remaining < 0 {
+		credits.Remaining = 0
+	}
+	return credits, nil
+}
+
+// checkConflict blocks votes and scorecards from members of the submission's
+// team and from judges who declared a conflict with it.
+func (s *voteService) checkConflict(eventID uint, submission *models.Submission, address string) error {
+	declared, err := s.conflictRepo.Exists(eventID, address, submission.TeamID)
+	if err != nil {
+		return err
+	}
+	switch voteConflict(&submission.Team, address, declared) {
+	case ConflictRuleTeamMember:
+		return errors.New("you cannot vote for your own team's submission")
+	case ConflictRuleDeclared:
+		return errors.New("you declared a conflict of interest with this team")
+	}
+	return nil
+}
+
+// checkJudgeAssigned restricts judges to their assigned submissions once
+// the organizer has assigned judges for the event.
+func (s *voteService) checkJudgeAssigned(eventID uint, submissionID uint, address string) error {
+	assigned, err := s.assignmentRepo.HasActive(eventID)
+	if err != nil || !assigned {
+		return err
+	}
+	assigned, err = s.assignmentRepo.IsAssigned(submissionID, address)
+	if err != nil {
+		return err
+	}
+	if !assigned {
+		return errors.New("judge is not assigned to this submission")
+	}
+	return nil
+}
+
+// checkVotingOpen rejects votes and scores outside the voting stage and window.
+func checkVotingOpen(event *models.Event, now time.Time) error {
+	if event.CurrentStage != models.StageVoting {
+		return errors.New("event is not in voting stage")
+	}
+	if event.VotingStartTime != nil && now.Before(*event.VotingStartTime) {
+		return errors.New("voting has not started yet")
+	}
+	if event.VotingEndTime != nil && now.After(*event.VotingEndTime) {
+		return errors.New("voting has already ended")
+	}
+	return nil
+}
+
+// validatePublicVoting checks an event's public voting mode and budget.
+func validatePublicVoting(event *models.Event) error {
+	switch event.PublicVotingMode {
+	case models.PublicVotingStandard, models.PublicVotingQuadratic:
+	default:
+		return errors.New("unsupported public voting mode")
+	}
+	if event.PublicVoteCredits < 0 {
+		return errors.New("public vote credits cannot be negative")
+	}
+	return nil
+}
+
+// publicVoteBudget is the credit budget of each public voter in quadratic mode.
+func publicVoteBudget(event *models.Event) int {
+	if event.PublicVoteCredits > 0 {
+		return event.PublicVoteCredits
+	}
+	return defaultPublicVoteCredits
+}
+
+// voteCredits is the number of credits a vote spends: votes² for quadratic
+// public votes, nothing otherwise.
+func voteCredits(req *CastVoteRequest, event *models.Event) int {
+	if req.VoterType != models.VoterTypePublic || event.PublicVotingMode != models.PublicVotingQuadratic {
+		return 0
+	}
+	return req.Votes * req.Votes
+}
+
+// talliesHidden reports whether vote tallies must be withheld from everyone
+// except the organizer.
+func talliesHidden(event *models.Event) bool {
+	return event.ResultsFrozen || votesSealed(event, time.Now())
+}
+
 func normalizeAddress(address string) string {
 	return strings.ToLower(strings.TrimSpace(address))
 }
@@ -0,0 +1,41 @@
+package services
+
+import (
+	"hackathon-platform/backend/models"
+	"hackathon-platform/backend/repositories"
+	"testing"
+)
+
+type stubVoteRepo struct {
+	repositories.VoteRepository
+	spent int64
+}
+
+func (r *stubVoteRepo) SumCreditsByEventAndVoter(eventID uint, address string) (int64, error) {
+	return r.spent, nil
+}
+
+func TestQuadraticWeightRejectsVotesBeyondTheBudget(t *testing.T) {
+	service := &voteService{voteRepo: &stubVoteRepo{spent: 0}}
+	event := &models.Event{ID: 1, PublicVotingMode: models.PublicVotingQuadratic, PublicVoteCredits: 100}
+
+	weight, err := service.quadraticWeight(&CastVoteRequest{Votes: 10}, event, testOrganizer)
+	if err != nil || weight != 10 {
+		t.Fatalf("10 votes on a 100 credit budget: weight %v, err %v", weight, err)
+	}
+
+	// 3037000500² overflows int64 to a negative cost
+	for _, votes := range []int{11, 3037000500, 0, -1} {
+		if weight, err := service.quadraticWeight(&CastVoteRequest{Votes: votes}, event, testOrganizer); err == nil {
+			t.Errorf("%d votes were accepted with weight %v", votes, weight)
+		}
+	}
+}
+
+func TestIsqrt(t *testing.T) {
+	for n, want := range map[int]int{0: 0, 1: 1, 99: 9, 100: 10, 101: 10, 1<<62 - 1: 1<<31 - 1} {
+		if got := isqrt(n); got != want {
+			t.Errorf("isqrt(%d) = %d, want %d", n, got, want)
+		}
+	}
+}
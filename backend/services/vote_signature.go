@@ -0,0 +1,383 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hackathon-platform/backend/models"
+	"hackathon-platform/backend/repositories"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const (
+	voteDomainName    = "HackathonPlatform"
+	voteDomainVersion = "1"
+)
+
+// voteDomainTypes is the EIP-712 domain shared by every signed voting
+// message.
+var voteDomainTypes = []apitypes.Type{
+	{Name: "name", Type: "string"},
+	{Name: "version", Type: "string"},
+	{Name: "chainId", Type: "uint256"},
+}
+
+// voteTypes is the EIP-712 schema of a signed vote. The weight is a decimal
+// string because judge and sponsor weights need not be whole numbers.
+var voteTypes = apitypes.Types{
+	"EIP712Domain": voteDomainTypes,
+	"Vote": {
+		{Name: "eventId", Type: "uint256"},
+		{Name: "submissionId", Type: "uint256"},
+		{Name: "voter", Type: "address"},
+		{Name: "voterType", Type: "string"},
+		{Name: "weight", Type: "string"},
+		{Name: "nonce", Type: "uint256"},
+	},
+}
+
+// ballotTypes is the EIP-712 schema of a signed ranked ballot.
+var ballotTypes = apitypes.Types{
+	"EIP712Domain": voteDomainTypes,
+	"Ballot": {
+		{Name: "eventId", Type: "uint256"},
+		{Name: "voter", Type: "address"},
+		{Name: "rankings", Type: "uint256[]"},
+		{Name: "nonce", Type: "uint256"},
+	},
+}
+
+// commitmentTypes is the EIP-712 schema of a signed commit-reveal
+// commitment.
+var commitmentTypes = apitypes.Types{
+	"EIP712Domain": voteDomainTypes,
+	"VoteCommitment": {
+		{Name: "eventId", Type: "uint256"},
+		{Name: "voter", Type: "address"},
+		{Name: "voterType", Type: "string"},
+		{Name: "commitment", Type: "bytes32"},
+		{Name: "nonce", Type: "uint256"},
+	},
+}
+
+// scorecardTypes is the EIP-712 schema of a signed rubric scorecard.
+var scorecardTypes = apitypes.Types{
+	"EIP712Domain": voteDomainTypes,
+	"Scorecard": {
+		{Name: "eventId", Type: "uint256"},
+		{Name: "submissionId", Type: "uint256"},
+		{Name: "judge", Type: "address"},
+		{Name: "scores", Type: "CriterionScore[]"},
+		{Name: "nonce", Type: "uint256"},
+	},
+	"CriterionScore": {
+		{Name: "criterionId", Type: "uint256"},
+		{Name: "score", Type: "int256"},
+		{Name: "comment", Type: "string"},
+	},
+}
+
+// SignedTypedData is an EIP-712 message a voter has to sign, with the nonce
+// it carries. Ballots, commitments and scorecards use it; votes use
+// VoteTypedData, which also reports the weight.
+type SignedTypedData struct {
+	TypedData apitypes.TypedData `json:"typed_data"`
+	Digest    string             `json:"digest"`
+	Nonce     uint64             `json:"nonce"`
+}
+
+// VoteTypedData is the EIP-712 message a voter has to sign to cast a vote,
+// with the weight the server will record and the nonce to use.
+type VoteTypedData struct {
+	TypedData apitypes.TypedData `json:"typed_data"`
+	Digest    string             `json:"digest"`
+	Weight    float64            `json:"weight"`
+	Nonce     uint64             `json:"nonce"`
+}
+
+// VoteVerification is the result of re-checking a stored vote's signature.
+type VoteVerification struct {
+	VoteID    uint   `json:"vote_id"`
+	Valid     bool   `json:"valid"`
+	Signer    string `json:"signer,omitempty"`
+	Digest    string `json:"digest,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+	Signature string `json:"signature"`
+}
+
+// PrepareVote runs the same checks as CastVote and returns the typed data
+// the voter must sign. Nothing is recorded.
+func (s *voteService) PrepareVote(req *CastVoteRequest) (*VoteTypedData, error) {
+	address := normalizeAddress(req.VoterAddress)
+	if address == "" {
+		return nil, errors.New("invalid voter address")
+	}
+	if req.ChainID <= 0 {
+		return nil, errors.New("chain_id is required")
+	}
+
+	event, err := s.eventRepo.GetByID(req.EventID)
+	if err != nil {
+		return nil, errors.New("event not found")
+	}
+
+	if event.CommitReveal {
+		if commitRevealPhase(event, time.Now()) != RevealPhase {
+			return nil, errors.New("commit-reveal votes are signed when they are revealed")
+		}
+	} else if err := checkVotingOpen(event, time.Now()); err != nil {
+		return nil, err
+	}
+
+	submission, weight, err := s.voteWeight(req, event, address)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := s.nonceRepo.Next(event.ID, address)
+	if err != nil {
+		return nil, err
+	}
+	typedData := voteTypedData(req.ChainID, event.ID, submission.ID, address, req.VoterType, weight, nonce)
+	digest, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return &VoteTypedData{
+		TypedData: typedData,
+		Digest:    hexutil.Encode(digest),
+		Weight:    weight,
+		Nonce:     nonce,
+	}, nil
+}
+
+// VerifyVote re-checks the signature of a stored vote against its signed
+// payload, the same way a third party would.
+func (s *voteService) VerifyVote(id uint) (*VoteVerification, error) {
+	vote, err := s.voteRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &VoteVerification{VoteID: vote.ID, Signature: vote.Signature}
+	if vote.SignedPayload == "" {
+		result.Reason = "vote was recorded without a signed payload"
+		return result, nil
+	}
+	var typedData apitypes.TypedData
+	if err := json.Unmarshal([]byte(vote.SignedPayload), &typedData); err != nil || typedData.PrimaryType != "Vote" {
+		result.Reason = "signed payload is not a vote typed data message"
+		return result, nil
+	}
+
+	signer, digest, err := recoverVoteSigner(typedData, vote.Signature)
+	if err != nil {
+		result.Reason = err.Error()
+		return result, nil
+	}
+	result.Signer = strings.ToLower(signer.Hex())
+	result.Digest = hexutil.Encode(digest)
+
+	// The payload has to describe the vote that was stored, not just carry a valid signature
+	expected := voteTypedData(0, vote.EventID, vote.SubmissionID, vote.VoterAddress, vote.VoterType, vote.Weight, vote.Nonce).Message
+	for key, value := range expected {
+		if fmt.Sprint(typedData.Message[key]) != fmt.Sprint(value) {
+			result.Reason = fmt.Sprintf("signed %s does not match the stored vote", key)
+			return result, nil
+		}
+	}
+	if result.Signer != normalizeAddress(vote.VoterAddress) {
+		result.Reason = "signature does not match voter address"
+		return result, nil
+	}
+	result.Valid = true
+	return result, nil
+}
+
+// verifyVoteSignature checks that the voter signed the typed data for this
+// exact vote and consumes the nonce. It returns the payload to store.
+func (s *voteService) verifyVoteSignature(req *CastVoteRequest, event *models.Event, submission *models.Submission, address string, weight float64) (string, error) {
+	typedData := voteTypedData(req.ChainID, event.ID, submission.ID, address, req.VoterType, weight, req.Nonce)
+	return consumeSignedTypedData(s.nonceRepo, "vote", typedData, event.ID, address, req.Signature, req.Nonce)
+}
+
+// prepareSignedTypedData fills in the voter's next nonce and returns the
+// message to sign. build must not depend on anything but its arguments.
+func prepareSignedTypedData(nonces repositories.VoteNonceRepository, eventID uint, address string, chainID int64, build func(nonce uint64) apitypes.TypedData) (*SignedTypedData, error) {
+	if chainID <= 0 {
+		return nil, errors.New("chain_id is required")
+	}
+	nonce, err := nonces.Next(eventID, address)
+	if err != nil {
+		return nil, err
+	}
+	typedData := build(nonce)
+	digest, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return &SignedTypedData{TypedData: typedData, Digest: hexutil.Encode(digest), Nonce: nonce}, nil
+}
+
+// consumeSignedTypedData checks that address signed typedData and consumes
+// the nonce it carries, so the signature cannot be replayed. what names the
+// message in errors. It returns the payload to store.
+func consumeSignedTypedData(nonces repositories.VoteNonceRepository, what string, typedData apitypes.TypedData, eventID uint, address string, signature string, nonce uint64) (string, error) {
+	if signature == "" {
+		return "", fmt.Errorf("an EIP-712 %s signature is required", what)
+	}
+	if typedData.Domain.ChainId == nil || (*big.Int)(typedData.Domain.ChainId).Sign() <= 0 {
+		return "", errors.New("chain_id is required")
+	}
+
+	signer, _, err := recoverVoteSigner(typedData, signature)
+	if err != nil {
+		return "", err
+	}
+	if strings.ToLower(signer.Hex()) != address {
+		return "", fmt.Errorf("%s signature does not match voter address", what)
+	}
+
+	consumed, err := nonces.Consume(eventID, address, nonce)
+	if err != nil {
+		return "", err
+	}
+	if !consumed {
+		return "", fmt.Errorf("%s nonce was already used or is out of order; request new typed data", what)
+	}
+
+	payload, err := json.Marshal(typedData)
+	if err != nil {
+		return "", err
+	}
+	return string(payload), nil
+}
+
+// voteDomain is the EIP-712 domain of voting messages on chainID.
+func voteDomain(chainID int64) apitypes.TypedDataDomain {
+	return apitypes.TypedDataDomain{
+		Name:    voteDomainName,
+		Version: voteDomainVersion,
+		ChainId: (*math.HexOrDecimal256)(big.NewInt(chainID)),
+	}
+}
+
+// ballotTypedData builds the EIP-712 message for a ranked ballot.
+func ballotTypedData(chainID int64, eventID uint, voter string, rankings []models.BallotRanking, nonce uint64) apitypes.TypedData {
+	ranked := make([]interface{}, 0, len(rankings))
+	for _, ranking := range rankings {
+		ranked = append(ranked, strconv.FormatUint(uint64(ranking.SubmissionID), 10))
+	}
+	return apitypes.TypedData{
+		Types:       ballotTypes,
+		PrimaryType: "Ballot",
+		Domain:      voteDomain(chainID),
+		Message: apitypes.TypedDataMessage{
+			"eventId":  strconv.FormatUint(uint64(eventID), 10),
+			"voter":    normalizeAddress(voter),
+			"rankings": ranked,
+			"nonce":    strconv.FormatUint(nonce, 10),
+		},
+	}
+}
+
+// commitmentTypedData builds the EIP-712 message for a vote commitment.
+func commitmentTypedData(chainID int64, eventID uint, voter string, voterType models.VoterType, commitment string, nonce uint64) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types:       commitmentTypes,
+		PrimaryType: "VoteCommitment",
+		Domain:      voteDomain(chainID),
+		Message: apitypes.TypedDataMessage{
+			"eventId":    strconv.FormatUint(uint64(eventID), 10),
+			"voter":      normalizeAddress(voter),
+			"voterType":  string(voterType),
+			"commitment": commitment,
+			"nonce":      strconv.FormatUint(nonce, 10),
+		},
+	}
+}
+
+// scorecardTypedData builds the EIP-712 message for a judge's scorecard.
+func scorecardTypedData(chainID int64, eventID uint, submissionID uint, judge string, scores []models.RubricScore, nonce uint64) apitypes.TypedData {
+	signed := make([]interface{}, 0, len(scores))
+	for _, score := range scores {
+		signed = append(signed, map[string]interface{}{
+			"criterionId": strconv.FormatUint(uint64(score.CriterionID), 10),
+			"score":       strconv.Itoa(score.Score),
+			"comment":     score.Comment,
+		})
+	}
+	return apitypes.TypedData{
+		Types:       scorecardTypes,
+		PrimaryType: "Scorecard",
+		Domain:      voteDomain(chainID),
+		Message: apitypes.TypedDataMessage{
+			"eventId":      strconv.FormatUint(uint64(eventID), 10),
+			"submissionId": strconv.FormatUint(uint64(submissionID), 10),
+			"judge":        normalizeAddress(judge),
+			"scores":       signed,
+			"nonce":        strconv.FormatUint(nonce, 10),
+		},
+	}
+}
+
+// voteTypedData builds the EIP-712 message for one vote.
+func voteTypedData(chainID int64, eventID uint, submissionID uint, voter string, voterType models.VoterType, weight float64, nonce uint64) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types:       voteTypes,
+		PrimaryType: "Vote",
+		Domain:      voteDomain(chainID),
+		Message: apitypes.TypedDataMessage{
+			"eventId":      strconv.FormatUint(uint64(eventID), 10),
+			"submissionId": strconv.FormatUint(uint64(submissionID), 10),
+			"voter":        normalizeAddress(voter),
+			"voterType":    string(voterType),
+			"weight":       formatVoteWeight(weight),
+			"nonce":        strconv.FormatUint(nonce, 10),
+		},
+	}
+}
+
+// formatVoteWeight renders a weight at the precision the votes table keeps,
+// so a stored vote still matches what was signed.
+func formatVoteWeight(weight float64) string {
+	rounded, _ := strconv.ParseFloat(strconv.FormatFloat(weight, 'f', 6, 64), 64)
+	return strconv.FormatFloat(rounded, 'f', -1, 64)
+}
+
+// recoverVoteSigner returns the address that signed typedData together with
+// the EIP-712 digest. Both 27/28 and 0/1 recovery IDs are accepted.
+func recoverVoteSigner(typedData apitypes.TypedData, signature string) (common.Address, []byte, error) {
+	sig, err := hexutil.Decode("0x" + strings.TrimPrefix(strings.TrimSpace(signature), "0x"))
+	if err != nil {
+		return common.Address{}, nil, errors.New("invalid vote signature encoding")
+	}
+	if len(sig) != 65 {
+		return common.Address{}, nil, errors.New("invalid vote signature length")
+	}
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+	if sig[64] > 1 {
+		return common.Address{}, nil, errors.New("invalid recovery id")
+	}
+
+	digest, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	pubKey, err := crypto.SigToPub(digest, sig)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	return crypto.PubkeyToAddress(*pubKey), digest, nil
+}
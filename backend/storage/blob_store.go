@@ -0,0 +1,113 @@
+package storage
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrBlobNotFound is returned when a key has no stored content.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore persists immutable content under a content-derived key. Keys are
+// CIDs, so writing the same key twice always writes the same bytes.
+type BlobStore interface {
+	Put(key string, r io.Reader) error
+	Open(key string) (io.ReadCloser, error)
+	Exists(key string) (bool, error)
+	Delete(key string) error
+	// TempFile returns a scratch file for staging an upload before its key is
+	// known. Callers remove it when done.
+	TempFile() (*os.File, error)
+}
+
+// LocalStore keeps blobs on the local filesystem, fanned out into
+// two-character subdirectories to keep directory sizes manageable.
+type LocalStore struct {
+	root string
+}
+
+func NewLocalStore(root string) (*LocalStore, error) {
+	if err := os.MkdirAll(filepath.Join(root, "tmp"), 0o755); err != nil {
+		return nil, err
+	}
+	return &LocalStore{root: root}, nil
+}
+
+func (s *LocalStore) path(key string) (string, error) {
+	if len(key) < 4 || strings.ContainsAny(key, `/\.`) {
+		return "", errors.New("invalid blob key")
+	}
+	return filepath.Join(s.root, key[len(key)-2:], key), nil
+}
+
+func (s *LocalStore) Put(key string, r io.Reader) error {
+	dst, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(dst); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := s.TempFile()
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	// Rename is atomic, so readers never observe a partially written blob
+	return os.Rename(tmp.Name(), dst)
+}
+
+func (s *LocalStore) Open(key string) (io.ReadCloser, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(p)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrBlobNotFound
+	}
+	return f, err
+}
+
+func (s *LocalStore) Exists(key string) (bool, error) {
+	p, err := s.path(key)
+	if err != nil {
+		return false, err
+	}
+	_, err = os.Stat(p)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (s *LocalStore) Delete(key string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(p)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (s *LocalStore) TempFile() (*os.File, error) {
+	return os.CreateTemp(filepath.Join(s.root, "tmp"), "upload-*")
+}
@@ -0,0 +1,164 @@
+package storage
+
+import (
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"strings"
+)
+
+const (
+	cidVersion1   = 0x01
+	codecRaw      = 0x55
+	codecDagPB    = 0x70
+	multihashSHA2 = 0x12
+	sha256Length  = 0x20
+
+	// Chunk size and fan-out of the balanced layout `ipfs add` uses by default
+	chunkSize      = 256 << 10
+	maxDAGLinks    = 174
+	unixfsTypeFile = 2
+)
+
+var cidBase32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// dagLink is a child of a UnixFS node: its binary CID, the size of the
+// encoded subtree (Tsize) and the number of file bytes below it.
+type dagLink struct {
+	cid      []byte
+	tsize    uint64
+	fileSize uint64
+}
+
+// CIDBuilder computes the CID `ipfs add --cid-version=1 --raw-leaves`
+// assigns to the content written to it: 256 KiB raw leaves under a balanced
+// UnixFS DAG of dag-pb nodes. Content that fits in one chunk is a single raw
+// block, so its CID is the raw CID of the content.
+type CIDBuilder struct {
+	leaves  []dagLink
+	pending []byte
+}
+
+// NewCIDBuilder returns an empty builder.
+func NewCIDBuilder() *CIDBuilder {
+	return &CIDBuilder{pending: make([]byte, 0, chunkSize)}
+}
+
+// Write adds content. It never fails.
+func (b *CIDBuilder) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		take := chunkSize - len(b.pending)
+		if take > len(p) {
+			take = len(p)
+		}
+		b.pending = append(b.pending, p[:take]...)
+		p = p[take:]
+		if len(b.pending) == chunkSize {
+			b.flush()
+		}
+	}
+	return n, nil
+}
+
+func (b *CIDBuilder) flush() {
+	digest := sha256.Sum256(b.pending)
+	b.leaves = append(b.leaves, dagLink{
+		cid:      binaryCID(codecRaw, digest[:]),
+		tsize:    uint64(len(b.pending)),
+		fileSize: uint64(len(b.pending)),
+	})
+	b.pending = b.pending[:0]
+}
+
+// CID returns the base32 CIDv1 of everything written so far.
+func (b *CIDBuilder) CID() string {
+	leaves := b.leaves
+	if len(b.pending) > 0 || len(leaves) == 0 {
+		digest := sha256.Sum256(b.pending)
+		leaves = append(leaves[:len(leaves):len(leaves)], dagLink{
+			cid:      binaryCID(codecRaw, digest[:]),
+			tsize:    uint64(len(b.pending)),
+			fileSize: uint64(len(b.pending)),
+		})
+	}
+
+	// Group each level into nodes of up to maxDAGLinks children until a
+	// single root is left; this is the shape the balanced builder produces.
+	level := leaves
+	for len(level) > 1 {
+		var parents []dagLink
+		for start := 0; start < len(level); start += maxDAGLinks {
+			end := start + maxDAGLinks
+			if end > len(level) {
+				end = len(level)
+			}
+			parents = append(parents, fileNode(level[start:end]))
+		}
+		level = parents
+	}
+	return "b" + strings.ToLower(cidBase32.EncodeToString(level[0].cid))
+}
+
+// fileNode encodes a dag-pb UnixFS file node over children.
+func fileNode(children []dagLink) dagLink {
+	var data []byte
+	var fileSize uint64
+	for _, child := range children {
+		fileSize += child.fileSize
+	}
+	data = appendVarintField(data, 1, unixfsTypeFile)
+	data = appendVarintField(data, 3, fileSize)
+	for _, child := range children {
+		data = appendVarintField(data, 4, child.fileSize)
+	}
+
+	// dag-pb puts links before data
+	var node []byte
+	tsize := uint64(0)
+	for _, child := range children {
+		var link []byte
+		link = appendBytesField(link, 1, child.cid)
+		link = appendBytesField(link, 2, nil) // empty name
+		link = appendVarintField(link, 3, child.tsize)
+		node = appendBytesField(node, 2, link)
+		tsize += child.tsize
+	}
+	node = appendBytesField(node, 1, data)
+
+	digest := sha256.Sum256(node)
+	return dagLink{
+		cid:      binaryCID(codecDagPB, digest[:]),
+		tsize:    tsize + uint64(len(node)),
+		fileSize: fileSize,
+	}
+}
+
+func binaryCID(codec byte, sha256Digest []byte) []byte {
+	buf := make([]byte, 0, 4+len(sha256Digest))
+	buf = append(buf, cidVersion1, codec, multihashSHA2, sha256Length)
+	return append(buf, sha256Digest...)
+}
+
+func appendVarintField(buf []byte, field int, value uint64) []byte {
+	buf = binary.AppendUvarint(buf, uint64(field<<3))
+	return binary.AppendUvarint(buf, value)
+}
+
+func appendBytesField(buf []byte, field int, value []byte) []byte {
+	buf = binary.AppendUvarint(buf, uint64(field<<3|2))
+	buf = binary.AppendUvarint(buf, uint64(len(value)))
+	return append(buf, value...)
+}
+
+// IsCID reports whether s looks like a CID produced by CIDBuilder.
+func IsCID(s string) bool {
+	if len(s) < 2 || s[0] != 'b' {
+		return false
+	}
+	raw, err := cidBase32.DecodeString(strings.ToUpper(s[1:]))
+	if err != nil || len(raw) != 4+sha256Length {
+		return false
+	}
+	return raw[0] == cidVersion1 && (raw[1] == codecRaw || raw[1] == codecDagPB) && raw[2] == multihashSHA2 && raw[3] == sha256Length
+}
@@ -14,10 +14,21 @@ contract SubmissionRegistry {
         uint256 timestamp;
     }
 
+    struct RootAnchor {
+        bytes32 root;
+        uint256 submissionCount;
+        address anchoredBy;
+        uint256 timestamp;
+    }
+
     // Mapping submission ID => data
     mapping(uint256 => Submission) public submissions;
     uint256 public submissionCounter;
 
+    // Mapping anchoring operator => event ID => Merkle root over submission
+    // fingerprints. Keyed by sender so nobody can pre-empt another operator.
+    mapping(address => mapping(uint256 => RootAnchor)) public eventRoots;
+
     event SubmissionRegistered(
         uint256 indexed submissionId,
         uint256 indexed eventId,
@@ -26,6 +37,13 @@ contract SubmissionRegistry {
         string metadataURI
     );
 
+    event SubmissionRootAnchored(
+        uint256 indexed eventId,
+        bytes32 root,
+        uint256 submissionCount,
+        address indexed anchoredBy
+    );
+
     /**
      * @dev Register a submission fingerprint
      * @param eventId Event identifier
@@ -61,6 +79,31 @@ contract SubmissionRegistry {
         return submissionCounter;
     }
 
+    /**
+     * @dev Anchor the Merkle root of an event's submissions after the deadline.
+     *      A root can only be set once per operator and event so it cannot be rewritten later.
+     * @param eventId Event identifier
+     * @param root RFC 6962 style SHA-256 Merkle root over submission fingerprints
+     * @param submissionCount Number of leaves in the tree
+     */
+    function anchorSubmissionRoot(
+        uint256 eventId,
+        bytes32 root,
+        uint256 submissionCount
+    ) public {
+        require(root != bytes32(0), "Invalid root");
+        require(eventRoots[msg.sender][eventId].root == bytes32(0), "Root already anchored");
+
+        eventRoots[msg.sender][eventId] = RootAnchor({
+            root: root,
+            submissionCount: submissionCount,
+            anchoredBy: msg.sender,
+            timestamp: block.timestamp
+        });
+
+        emit SubmissionRootAnchored(eventId, root, submissionCount, msg.sender);
+    }
+
     /**
      * @dev Get submission info
      */
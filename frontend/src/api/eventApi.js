@@ -45,6 +45,17 @@ export const eventApi = {
     const response = await api.patch(`/events/${id}/stage`, { stage })
     return response.data
   },
+
+  // Subscribe to live updates (SSE). Returns a function that closes the stream.
+  // viewer is an optional organizer proof (see signViewerProof) for hidden tallies.
+  subscribeLive: (id, topics, onUpdate, viewer) => {
+    const params = new URLSearchParams({ topics: topics.join(','), ...viewer })
+    const source = new EventSource(`${API_BASE_URL}/events/${id}/live?${params}`)
+    topics.forEach((topic) => {
+      source.addEventListener(topic, (e) => onUpdate(JSON.parse(e.data)))
+    })
+    return () => source.close()
+  },
 }
 
 export default eventApi
@@ -51,6 +51,26 @@ export const sponsorshipApi = {
     })
     return response.data
   },
+
+  // Get sponsor voting rules for an event
+  getVotingRules: async (eventId) => {
+    const response = await api.get(`/events/${eventId}/sponsor-voting-rules`)
+    return response.data
+  },
+
+  // Replace sponsor voting rules for an event (organizer only)
+  setVotingRules: async (eventId, payload) => {
+    const response = await api.put(`/events/${eventId}/sponsor-voting-rules`, payload)
+    return response.data
+  },
+
+  // Get a sponsor's voting power for an event
+  getVotingPower: async (eventId, sponsorAddress) => {
+    const response = await api.get(`/sponsorships/event/${eventId}/voting-power`, {
+      params: { sponsor_address: sponsorAddress },
+    })
+    return response.data
+  },
 }
 
 export default sponsorshipApi
@@ -10,6 +10,17 @@ const api = axios.create({
 })
 
 export const submissionApi = {
+  // Upload a file to the built-in store; returns the record with cid/sha256
+  uploadFile: async (eventId, file, uploadedBy) => {
+    const form = new FormData()
+    form.append('uploaded_by', uploadedBy)
+    form.append('file', file)
+    const response = await api.post(`/uploads/event/${eventId}`, form, {
+      headers: { 'Content-Type': 'multipart/form-data' },
+    })
+    return response.data
+  },
+
   createSubmission: async (data) => {
     const response = await api.post('/submissions', data)
     return response.data
@@ -35,24 +46,98 @@ export const submissionApi = {
     return response.data
   },
 
-  approveSubmission: async (id, organizerAddress, comment) => {
+  // override decides despite reviewer screening and is logged to its audit trail
+  approveSubmission: async (id, organizerAddress, comment, override = false) => {
     const response = await api.patch(`/submissions/${id}/approve`, {
       organizer_address: organizerAddress,
       comment,
+      override,
     })
     return response.data
   },
 
-  rejectSubmission: async (id, organizerAddress, comment) => {
+  // requestChanges reopens the submission for the team instead of rejecting it
+  rejectSubmission: async (id, organizerAddress, comment, requestChanges = false, override = false) => {
     const response = await api.patch(`/submissions/${id}/reject`, {
       organizer_address: organizerAddress,
       comment,
+      request_changes: requestChanges,
+      override,
     })
     return response.data
   },
 
-  deleteSubmission: async (id) => {
-    const response = await api.delete(`/submissions/${id}`)
+  withdrawSubmission: async (id, memberAddress, reason) => {
+    const response = await api.post(`/submissions/${id}/withdraw`, {
+      member_address: memberAddress,
+      reason,
+    })
+    return response.data
+  },
+
+  getStatusHistory: async (id) => {
+    const response = await api.get(`/submissions/${id}/status-history`)
+    return response.data
+  },
+
+  // Submit a draft; with member signoff required it waits for the team
+  finalizeSubmission: async (id, submittedBy) => {
+    const response = await api.post(`/submissions/${id}/finalize`, {
+      submitted_by: submittedBy,
+    })
+    return response.data
+  },
+
+  signOffSubmission: async (id, memberAddress) => {
+    const response = await api.post(`/submissions/${id}/signoff`, {
+      member_address: memberAddress,
+    })
+    return response.data
+  },
+
+  getSignoffStatus: async (id) => {
+    const response = await api.get(`/submissions/${id}/signoffs`)
+    return response.data
+  },
+
+  getRequirements: async (eventId) => {
+    const response = await api.get(`/submissions/event/${eventId}/requirements`)
+    return response.data
+  },
+
+  // Failing submissions get a 422 whose `fields` lists every unmet requirement
+  setRequirements: async (eventId, requirements) => {
+    const response = await api.put(`/submissions/event/${eventId}/requirements`, requirements)
+    return response.data
+  },
+
+  getScreeningQueue: async (reviewerAddress, eventId) => {
+    const response = await api.get('/submissions/screening/queue', {
+      params: { reviewer_address: reviewerAddress, event_id: eventId },
+    })
+    return response.data
+  },
+
+  submitScreeningVerdict: async (id, reviewerAddress, verdict, notes) => {
+    const response = await api.post(`/submissions/${id}/screening/verdicts`, {
+      reviewer_address: reviewerAddress,
+      verdict,
+      notes,
+    })
+    return response.data
+  },
+
+  getScreeningStatus: async (id, organizerAddress) => {
+    const response = await api.get(`/submissions/${id}/screening`, {
+      params: { organizer_address: organizerAddress },
+    })
+    return response.data
+  },
+
+  deleteSubmission: async (id, requesterAddress) => {
+    const response = await api.delete(`/submissions/${id}`, {
+      params: { requester_address: requesterAddress },
+    })
     return response.data
   },
 }
@@ -1,4 +1,5 @@
 import axios from 'axios'
+import { ethers } from 'ethers'
 
 const API_BASE_URL = '/api/v1'
 
@@ -15,18 +16,100 @@ export const voteApi = {
     return response.data
   },
 
-  getVotesByEvent: async (eventId) => {
-    const response = await api.get(`/votes/event/${eventId}`)
+  // viewer is an optional proof from signViewerProof for hidden tallies
+  getVotesByEvent: async (eventId, viewer) => {
+    const response = await api.get(`/votes/event/${eventId}`, {
+      params: { ...viewer },
+    })
+    return response.data
+  },
+
+  getVoteSummary: async (eventId, viewer) => {
+    const response = await api.get(`/votes/event/${eventId}/summary`, {
+      params: { ...viewer },
+    })
     return response.data
   },
 
-  getVoteSummary: async (eventId) => {
-    const response = await api.get(`/votes/event/${eventId}/summary`)
+  getVoteCredits: async (eventId, voterAddress) => {
+    const response = await api.get(`/votes/event/${eventId}/credits`, {
+      params: { voter_address: voterAddress },
+    })
     return response.data
   },
 
-  getVotesBySubmission: async (submissionId) => {
-    const response = await api.get(`/votes/submission/${submissionId}`)
+  getVotesBySubmission: async (submissionId, viewer) => {
+    const response = await api.get(`/votes/submission/${submissionId}`, {
+      params: { ...viewer },
+    })
+    return response.data
+  },
+
+  // Commit-reveal: keep the salt until the reveal window opens. Sign the
+  // typed data from prepareCommitment and send signature, nonce and chain_id
+  commitVote: async (payload) => {
+    const response = await api.post('/votes/commit', payload)
+    return response.data
+  },
+
+  prepareCommitment: async (payload) => {
+    const response = await api.post('/votes/commit/typed-data', payload)
+    return response.data
+  },
+
+  prepareVote: async (payload) => {
+    const response = await api.post('/votes/typed-data', payload)
+    return response.data
+  },
+
+  verifyVote: async (voteId) => {
+    const response = await api.get(`/votes/${voteId}/verify`)
+    return response.data
+  },
+
+  revealVote: async (payload) => {
+    const response = await api.post('/votes/reveal', payload)
+    return response.data
+  },
+
+  getCommitStatus: async (eventId, voterAddress) => {
+    const response = await api.get(`/votes/event/${eventId}/commitments`, {
+      params: { voter_address: voterAddress },
+    })
+    return response.data
+  },
+
+  checkPublicEligibility: async (eventId, voterAddress, offchainProof) => {
+    const response = await api.get(`/events/${eventId}/public-voters/eligibility`, {
+      params: { voter_address: voterAddress, offchain_proof: offchainProof },
+    })
+    return response.data
+  },
+
+  getPublicAllowlist: async (eventId, organizerAddress) => {
+    const response = await api.get(`/events/${eventId}/public-voters/allowlist`, {
+      params: { organizer_address: organizerAddress },
+    })
+    return response.data
+  },
+
+  uploadPublicAllowlist: async (eventId, organizerAddress, addresses, replace = false) => {
+    const response = await api.put(`/events/${eventId}/public-voters/allowlist`, {
+      organizer_address: organizerAddress,
+      addresses,
+      replace,
+    })
+    return response.data
+  },
+
+  uploadPublicAllowlistFile: async (eventId, organizerAddress, file, replace = false) => {
+    const form = new FormData()
+    form.append('organizer_address', organizerAddress)
+    form.append('replace', String(replace))
+    form.append('file', file)
+    const response = await api.put(`/events/${eventId}/public-voters/allowlist`, form, {
+      headers: { 'Content-Type': 'multipart/form-data' },
+    })
     return response.data
   },
 
@@ -37,6 +120,31 @@ export const voteApi = {
     return response.data
   },
 
+  // Sign the typed data from prepareBallot and send signature, nonce and chain_id
+  castBallot: async (payload) => {
+    const response = await api.post('/ballots', payload)
+    return response.data
+  },
+
+  prepareBallot: async (payload) => {
+    const response = await api.post('/ballots/typed-data', payload)
+    return response.data
+  },
+
+  getBallot: async (eventId, voterAddress) => {
+    const response = await api.get(`/ballots/event/${eventId}`, {
+      params: { voter_address: voterAddress },
+    })
+    return response.data
+  },
+
+  getBallotResults: async (eventId, method, viewer) => {
+    const response = await api.get(`/ballots/event/${eventId}/results`, {
+      params: { method, ...viewer },
+    })
+    return response.data
+  },
+
   getJudges: async (eventId) => {
     const response = await api.get(`/events/${eventId}/judges`)
     return response.data
@@ -53,6 +161,116 @@ export const voteApi = {
     })
     return response.data
   },
+
+  getJudgeAssignments: async (eventId, judgeAddress) => {
+    const response = await api.get(`/events/${eventId}/judge-assignments`, {
+      params: { judge_address: judgeAddress },
+    })
+    return response.data
+  },
+
+  assignJudges: async (eventId, payload) => {
+    const response = await api.post(`/events/${eventId}/judge-assignments`, payload)
+    return response.data
+  },
+
+  reassignJudge: async (eventId, payload) => {
+    const response = await api.post(`/events/${eventId}/judge-assignments/reassign`, payload)
+    return response.data
+  },
+
+  getJudgeQueue: async (eventId, judgeAddress) => {
+    const response = await api.get(`/events/${eventId}/judge-assignments/queue`, {
+      params: { judge_address: judgeAddress },
+    })
+    return response.data
+  },
+
+  getConflicts: async (eventId, judgeAddress) => {
+    const response = await api.get(`/events/${eventId}/conflicts`, {
+      params: { judge_address: judgeAddress },
+    })
+    return response.data
+  },
+
+  declareConflict: async (eventId, payload) => {
+    const response = await api.post(`/events/${eventId}/conflicts`, payload)
+    return response.data
+  },
+
+  removeConflict: async (eventId, conflictId, organizerAddress) => {
+    const response = await api.delete(`/events/${eventId}/conflicts/${conflictId}`, {
+      params: { organizer_address: organizerAddress },
+    })
+    return response.data
+  },
+
+  getConflictViolations: async (eventId, organizerAddress) => {
+    const response = await api.get(`/events/${eventId}/conflicts/violations`, {
+      params: { organizer_address: organizerAddress },
+    })
+    return response.data
+  },
+
+  getRubric: async (eventId) => {
+    const response = await api.get(`/events/${eventId}/rubric`)
+    return response.data
+  },
+
+  setRubric: async (eventId, payload) => {
+    const response = await api.put(`/events/${eventId}/rubric`, payload)
+    return response.data
+  },
+
+  getCalibration: async (eventId, organizerAddress) => {
+    const response = await api.get(`/events/${eventId}/rubric/calibration`, {
+      params: { organizer_address: organizerAddress },
+    })
+    return response.data
+  },
+
+  // Sign the typed data from prepareScorecard and send signature, nonce and chain_id
+  submitScorecard: async (payload) => {
+    const response = await api.post('/votes/scores', payload)
+    return response.data
+  },
+
+  prepareScorecard: async (payload) => {
+    const response = await api.post('/votes/scores/typed-data', payload)
+    return response.data
+  },
+
+  getScorecards: async (submissionId, viewer) => {
+    const response = await api.get(`/votes/submission/${submissionId}/scores`, {
+      params: { ...viewer },
+    })
+    return response.data
+  },
+}
+
+// computeVoteCommitment hashes a vote the same way the server checks reveals
+export const computeVoteCommitment = (eventId, submissionId, votes, voterAddress, salt) =>
+  ethers.keccak256(ethers.toUtf8Bytes(`${eventId}:${submissionId}:${votes || 0}:${voterAddress.trim().toLowerCase()}:${salt}`))
+
+// signViewerProof signs the organizer proof that unlocks hidden tallies.
+// The server accepts it for 10 minutes.
+export const signViewerProof = async (signer, eventId) => {
+  const timestamp = Math.floor(Date.now() / 1000)
+  const signature = await signer.signMessage(`View hidden tallies for event ${eventId}\nTimestamp: ${timestamp}`)
+  return {
+    viewer_address: (await signer.getAddress()).toLowerCase(),
+    viewer_signature: signature,
+    viewer_timestamp: timestamp,
+  }
+}
+
+// signVoteTypedData signs the typed data returned by prepareVote and the
+// other prepare calls. ethers
+// derives the domain type itself and rejects empty domain fields.
+export const signVoteTypedData = (signer, typedData) => {
+  const { EIP712Domain, ...types } = typedData.types
+  const domain = Object.fromEntries(Object.entries(typedData.domain).filter(([, value]) => value !== '' && value != null))
+  return signer.signTypedData(domain, types, typedData.message)
 }
 
 export default voteApi
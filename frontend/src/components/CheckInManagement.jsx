@@ -1,6 +1,7 @@
 import React, { useState, useEffect } from 'react'
 import { useParams } from 'react-router-dom'
 import { checkinApi } from '../api/checkinApi'
+import { eventApi } from '../api/eventApi'
 import './CheckInManagement.css'
 import Box from '@mui/material/Box'
 import Typography from '@mui/material/Typography'
@@ -27,6 +28,12 @@ const CheckInManagement = () => {
     loadData()
   }, [eventId])
 
+  useEffect(() => {
+    return eventApi.subscribeLive(eventId, ['checkins'], (update) => {
+      setCheckInCount(update.data?.count || 0)
+    })
+  }, [eventId])
+
   const loadData = async () => {
     try {
       setLoading(true)
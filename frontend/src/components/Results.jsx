@@ -40,6 +40,14 @@ function Results() {
     load()
   }, [eventId])
 
+  useEffect(() => {
+    return eventApi.subscribeLive(eventId, ['votes'], (update) => {
+      if (Array.isArray(update.data)) {
+        setSummary(update.data)
+      }
+    })
+  }, [eventId])
+
   const rankedList = useMemo(() => {
     if (!summary || summary.length === 0) return []
     const sorted = [...summary].sort((a, b) => b.total_weight - a.total_weight)
@@ -16,7 +16,7 @@ const SubmissionForm = () => {
     storage_url: '',
   })
   const [files, setFiles] = useState([
-    { file_name: '', file_type: '', url: '', hash: '' },
+    { file_name: '', file_type: '', url: '', hash: '', cid: '' },
   ])
   const [submitting, setSubmitting] = useState(false)
   const [error, setError] = useState(null)
@@ -37,8 +37,31 @@ const SubmissionForm = () => {
     setFiles(newFiles)
   }
 
+  const handleUpload = async (index, selected) => {
+    if (!selected) return
+    if (!walletAddress) {
+      setError('请先连接钱包再上传文件')
+      return
+    }
+    try {
+      const uploaded = await submissionApi.uploadFile(eventId, selected, walletAddress)
+      const newFiles = [...files]
+      newFiles[index] = {
+        file_name: uploaded.file_name,
+        file_type: uploaded.content_type,
+        url: `/api/v1/uploads/${uploaded.cid}`,
+        hash: uploaded.sha256,
+        cid: uploaded.cid,
+      }
+      setFiles(newFiles)
+      setError(null)
+    } catch (err) {
+      setError('上传失败: ' + (err.response?.data?.error || err.message))
+    }
+  }
+
   const addFile = () => {
-    setFiles((prev) => [...prev, { file_name: '', file_type: '', url: '', hash: '' }])
+    setFiles((prev) => [...prev, { file_name: '', file_type: '', url: '', hash: '', cid: '' }])
   }
 
   const removeFile = (index) => {
@@ -100,7 +123,7 @@ const SubmissionForm = () => {
         documentation: '',
         storage_url: '',
       })
-      setFiles([{ file_name: '', file_type: '', url: '', hash: '' }])
+      setFiles([{ file_name: '', file_type: '', url: '', hash: '', cid: '' }])
     } catch (err) {
       setError('提交失败: ' + (err.response?.data?.error || err.message))
     } finally {
@@ -218,7 +241,9 @@ const SubmissionForm = () => {
                   placeholder="Hash"
                   value={file.hash}
                   onChange={(e) => handleFileChange(index, 'hash', e.target.value)}
+                  disabled={!!file.cid}
                 />
+                <input type="file" onChange={(e) => handleUpload(index, e.target.files[0])} />
                 {files.length > 1 && (
                   <button type="button" onClick={() => removeFile(index)} className="btn btn-danger btn-sm">
                     删除
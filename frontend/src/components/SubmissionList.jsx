@@ -39,9 +39,13 @@ const SubmissionList = () => {
 
   const getStatusLabel = (status) => {
     const map = {
+      draft: '草稿',
+      awaiting_signoff: '待队员确认',
       pending: '待审核',
       approved: '已通过',
       rejected: '已拒绝',
+      changes_requested: '需修改',
+      withdrawn: '已撤回',
     }
     return map[status] || status
   }
@@ -1,17 +1,17 @@
 import React, { useEffect, useMemo, useState } from 'react'
 import { Link, useParams } from 'react-router-dom'
+import { ethers } from 'ethers'
 import { eventApi } from '../api/eventApi'
 import { submissionApi } from '../api/submissionApi'
-import { voteApi } from '../api/voteApi'
+import { signVoteTypedData, voteApi } from '../api/voteApi'
 import './VotingPanel.css'
 
 const defaultForm = {
   submission_id: '',
   voter_address: '',
   voter_type: 'public',
-  weight: '',
+  votes: '',
   reason: '',
-  signature: '',
   offchain_proof: '',
 }
 
@@ -88,25 +88,37 @@ function VotingPanel() {
       alert('请选择作品')
       return
     }
-    if (!form.voter_address) {
-      alert('请输入投票地址')
+    if (!window.ethereum) {
+      alert('请安装MetaMask钱包')
       return
     }
-    const payload = {
-      event_id: Number(eventId),
-      submission_id: Number(form.submission_id),
-      voter_address: form.voter_address,
-      voter_type: form.voter_type,
-      reason: form.reason || undefined,
-      signature: form.signature || undefined,
-      offchain_proof: form.offchain_proof || undefined,
-    }
-    if (form.weight) {
-      payload.weight = Number(form.weight)
-    }
     try {
       setProcessingVote(true)
-      await voteApi.castVote(payload)
+      // Votes are EIP-712 messages signed by the voter's wallet
+      const provider = new ethers.BrowserProvider(window.ethereum)
+      await provider.send('eth_requestAccounts', [])
+      const signer = await provider.getSigner()
+      const signerAddress = await signer.getAddress()
+      if (form.voter_address && form.voter_address.toLowerCase() !== signerAddress.toLowerCase()) {
+        alert('投票地址与当前钱包地址不一致')
+        return
+      }
+      const network = await provider.getNetwork()
+      const payload = {
+        event_id: Number(eventId),
+        submission_id: Number(form.submission_id),
+        voter_address: signerAddress,
+        voter_type: form.voter_type,
+        chain_id: Number(network.chainId),
+        reason: form.reason || undefined,
+        offchain_proof: form.offchain_proof || undefined,
+      }
+      if (form.votes) {
+        payload.votes = Number(form.votes)
+      }
+      const prepared = await voteApi.prepareVote(payload)
+      const signature = await signVoteTypedData(signer, prepared.typed_data)
+      await voteApi.castVote({ ...payload, signature, nonce: prepared.nonce })
       alert('投票成功')
       setForm((prev) => ({ ...defaultForm, submission_id: prev.submission_id }))
       await loadData()
@@ -216,7 +228,11 @@ function VotingPanel() {
             <li>允许赞助商投票：{event?.allow_sponsor_voting ? '是' : '否'}</li>
             <li>允许公众投票：{event?.allow_public_voting ? '是' : '否'}</li>
           </ul>
-          <p className="hint">公众默认每作品 1 票、每场最多 3 票，评委/赞助商凭权重自动计分。</p>
+          <p className="hint">
+            {event?.public_voting_mode === 'quadratic'
+              ? `公众每人 ${event.public_vote_credits || 100} 积分，对同一作品投 n 票消耗 n² 积分；评委/赞助商凭权重自动计分。`
+              : '公众每作品 1 票、每场最多 3 票，评委/赞助商凭权重自动计分。'}
+          </p>
         </div>
 
         <div className="card">
@@ -281,7 +297,7 @@ function VotingPanel() {
             </label>
 
             <label>
-              投票地址
+              投票地址（留空则使用当前钱包地址）
               <input
                 name="voter_address"
                 placeholder="0x..."
@@ -290,33 +306,26 @@ function VotingPanel() {
               />
             </label>
 
-            <label>
-              自定义权重（可选）
-              <input
-                name="weight"
-                type="number"
-                step="0.1"
-                placeholder="默认按系统策略计算"
-                value={form.weight}
-                onChange={handleFormChange}
-              />
-            </label>
+            {event?.public_voting_mode === 'quadratic' && form.voter_type === 'public' && (
+              <label>
+                票数（二次方投票，消耗票数² 积分）
+                <input
+                  name="votes"
+                  type="number"
+                  min="1"
+                  step="1"
+                  placeholder="1"
+                  value={form.votes}
+                  onChange={handleFormChange}
+                />
+              </label>
+            )}
 
             <label>
               投票理由（可选）
               <textarea name="reason" value={form.reason} onChange={handleFormChange} rows={2} />
             </label>
 
-            <label>
-              签名（可选）
-              <input
-                name="signature"
-                placeholder="0x..."
-                value={form.signature}
-                onChange={handleFormChange}
-              />
-            </label>
-
             <label>
               线下证明 / NFT 证据（可选）
               <input